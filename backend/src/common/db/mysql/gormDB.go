@@ -1,17 +1,20 @@
 package mysql
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
 // Room 방 정보 테이블
 type Room struct {
 	gorm.Model
-	RoomCode    string `json:"room_code" gorm:"column:room_code;type:varchar(50);uniqueIndex;not null;comment:방 고유 코드 (UUID)"`
-	Name        string `json:"name" gorm:"column:name;type:varchar(100);not null;comment:방 이름"`
-	OwnerUserID uint   `json:"owner_user_id" gorm:"column:owner_user_id;not null;index;comment:방 소유자 ID"`
-	Owner       *User  `json:"owner,omitempty" gorm:"foreignKey:OwnerUserID"`
-	Memos       []Memo `json:"memos,omitempty" gorm:"foreignKey:RoomID;constraint:OnDelete:CASCADE"`
+	RoomCode    string       `json:"room_code" gorm:"column:room_code;type:varchar(50);uniqueIndex;not null;comment:방 고유 코드 (UUID)"`
+	Name        string       `json:"name" gorm:"column:name;type:varchar(100);not null;comment:방 이름"`
+	OwnerUserID uint         `json:"owner_user_id" gorm:"column:owner_user_id;not null;index;comment:방 소유자 ID"`
+	Owner       *User        `json:"owner,omitempty" gorm:"foreignKey:OwnerUserID"`
+	Members     []RoomMember `json:"members,omitempty" gorm:"foreignKey:RoomID;constraint:OnDelete:CASCADE"`
+	Memos       []Memo       `json:"memos,omitempty" gorm:"foreignKey:RoomID;constraint:OnDelete:CASCADE"`
 }
 
 // TableName Room 테이블명 지정
@@ -19,6 +22,72 @@ func (Room) TableName() string {
 	return "rooms"
 }
 
+// RoomRole 방 멤버 권한
+type RoomRole string
+
+const (
+	RoomRoleOwner  RoomRole = "owner"  // 방 소유자 (방 관리 + 메모 편집/삭제)
+	RoomRoleEditor RoomRole = "editor" // 메모 작성/수정 가능
+	RoomRoleViewer RoomRole = "viewer" // 메모 조회만 가능
+)
+
+// IsValid 정의된 권한인지 확인
+func (r RoomRole) IsValid() bool {
+	return r == RoomRoleOwner || r == RoomRoleEditor || r == RoomRoleViewer
+}
+
+// CanEdit 메모 작성/수정 가능 여부
+func (r RoomRole) CanEdit() bool {
+	return r == RoomRoleOwner || r == RoomRoleEditor
+}
+
+// RoomMember 방 멤버 테이블 (탈퇴 시 재참여가 가능하도록 hard delete, 강퇴 기록은 room_kicks에 남김)
+type RoomMember struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	RoomID    uint      `json:"room_id" gorm:"column:room_id;not null;uniqueIndex:idx_room_user;comment:방 ID"`
+	UserID    uint      `json:"user_id" gorm:"column:user_id;not null;uniqueIndex:idx_room_user;index;comment:사용자 ID"`
+	Role      RoomRole  `json:"role" gorm:"column:role;type:varchar(20);not null;default:editor;comment:권한 (owner/editor/viewer)"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Room      *Room     `json:"room,omitempty" gorm:"foreignKey:RoomID"`
+	User      *User     `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName RoomMember 테이블명 지정
+func (RoomMember) TableName() string {
+	return "room_members"
+}
+
+// RoomKick 방 강퇴 기록 테이블 (기록이 있으면 초대 코드나 방 고유 코드로 다시 참여할 수 없음, 소유자가 강퇴를 취소하면 삭제)
+type RoomKick struct {
+	ID             uint      `json:"id" gorm:"primarykey"`
+	RoomID         uint      `json:"room_id" gorm:"column:room_id;not null;uniqueIndex:idx_room_user;comment:방 ID"`
+	UserID         uint      `json:"user_id" gorm:"column:user_id;not null;uniqueIndex:idx_room_user;comment:강퇴된 사용자 ID"`
+	KickedByUserID uint      `json:"kicked_by_user_id" gorm:"column:kicked_by_user_id;not null;comment:강퇴한 사용자 ID"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TableName RoomKick 테이블명 지정
+func (RoomKick) TableName() string {
+	return "room_kicks"
+}
+
+// RoomInvite 방 초대 코드 테이블 (만료 시간이 있는 짧은 코드)
+type RoomInvite struct {
+	gorm.Model
+	Code            string    `json:"code" gorm:"column:code;type:varchar(20);uniqueIndex;not null;comment:초대 코드"`
+	RoomID          uint      `json:"room_id" gorm:"column:room_id;not null;index;comment:방 ID"`
+	Role            RoomRole  `json:"role" gorm:"column:role;type:varchar(20);not null;default:editor;comment:참여 시 부여할 권한"`
+	CreatedByUserID uint      `json:"created_by_user_id" gorm:"column:created_by_user_id;not null;comment:초대 코드 생성자 ID"`
+	ExpiresAt       time.Time `json:"expires_at" gorm:"column:expires_at;not null;comment:만료 시간"`
+	Room            *Room     `json:"room,omitempty" gorm:"foreignKey:RoomID"`
+}
+
+// TableName RoomInvite 테이블명 지정
+func (RoomInvite) TableName() string {
+	return "room_invites"
+}
+
 // User 사용자 정보 테이블
 type User struct {
 	gorm.Model
@@ -26,6 +95,8 @@ type User struct {
 	Password         string  `json:"password" gorm:"column:password;type:varchar(255);not null;comment:암호화된 비밀번호"`
 	Nickname         string  `json:"nickname" gorm:"column:nickname;type:varchar(100);comment:사용자 닉네임"`
 	ProfileImageURL  *string `json:"profile_image_url,omitempty" gorm:"column:profile_image_url;type:varchar(500);comment:프로필 이미지 URL"`
+	ProfileImageKey  string  `json:"-" gorm:"column:profile_image_key;type:varchar(500);not null;default:'';comment:서버가 저장한 프로필 이미지 객체 키 (이미지 교체 시 이 키만 삭제)"`
+	ProfileImageHasVariants bool `json:"-" gorm:"column:profile_image_has_variants;not null;default:false;comment:프로필 이미지의 크기별 이미지 존재 여부"`
 	DefaultRoomID    *uint   `json:"default_room_id" gorm:"column:default_room_id;index;comment:기본 방 ID (회원가입 시 자동 생성된 방)"`
 	DefaultRoom      *Room   `json:"default_room,omitempty" gorm:"foreignKey:DefaultRoomID"`
 	Memos            []Memo  `json:"memos,omitempty" gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
@@ -43,22 +114,30 @@ type Memo struct {
 	RoomID          uint      `json:"room_id" gorm:"column:room_id;not null;index;comment:메모가 속한 방 ID"`
 	Title           string    `json:"title" gorm:"column:title;type:varchar(200);not null;comment:메모 제목"`
 	Content         string    `json:"content" gorm:"column:content;type:text;comment:메모 내용"`
-	ImageURL        string    `json:"image_url" gorm:"column:image_url;type:varchar(500);comment:메모 이미지 URL"`
+	ImageURL        string    `json:"image_url" gorm:"column:image_url;type:varchar(500);comment:외부 이미지 URL (image_key가 없을 때만 사용)"`
+	ImageKey        string    `json:"image_key" gorm:"column:image_key;type:varchar(500);not null;default:'';comment:대표 이미지 저장소 객체 키"`
 	Rating          uint8     `json:"rating" gorm:"column:rating;type:tinyint unsigned;default:0;index;comment:평점 (0-5) 또는 관심도 (1-5)"`
 	IsPinned        bool      `json:"is_pinned" gorm:"column:is_pinned;default:false;index;comment:고정 여부"`
 	Latitude        *float64  `json:"latitude" gorm:"column:latitude;type:double;comment:위도"`
 	Longitude       *float64  `json:"longitude" gorm:"column:longitude;type:double;comment:경도"`
+	Geohash         *string   `json:"-" gorm:"column:geohash;type:varchar(12);index;comment:위치 geohash (위도/경도와 함께 갱신)"`
 	LocationName    *string   `json:"location_name" gorm:"column:location_name;type:varchar(255);comment:위치 이름"`
 	Category        *string   `json:"category" gorm:"column:category;type:varchar(50);comment:장소 카테고리"`
+	VisitedAt       *time.Time `json:"visited_at,omitempty" gorm:"column:visited_at;index;comment:방문 일시 (사진 촬영 시각으로 채울 수 있음)"`
 	// Wishlist fields (Issue #19)
 	IsWishlist      bool      `json:"is_wishlist" gorm:"column:is_wishlist;default:false;not null;index:idx_user_wishlist;comment:위시리스트 여부 (true=가고싶은곳, false=방문한곳)"`
 	BusinessName    *string   `json:"business_name,omitempty" gorm:"column:business_name;type:varchar(255);comment:장소/가게명 (카카오/네이버)"`
 	BusinessPhone   *string   `json:"business_phone,omitempty" gorm:"column:business_phone;type:varchar(50);comment:전화번호"`
 	BusinessAddress *string   `json:"business_address,omitempty" gorm:"column:business_address;type:text;comment:주소"`
 	NaverPlaceURL   *string   `json:"naver_place_url,omitempty" gorm:"column:naver_place_url;type:varchar(500);comment:네이버 플레이스 URL"`
+	Version         uint      `json:"version" gorm:"column:version;not null;default:1;comment:메모 버전 (수정할 때마다 1 증가, ETag/If-Match 동시 수정 확인용)"`
+	Distance        *float64  `json:"-" gorm:"column:distance;->;-:migration"` // 조회 시 계산되는 기준 좌표와의 거리(m), 컬럼 아님
+	Relevance       *float64  `json:"-" gorm:"column:relevance;->;-:migration"` // 전문 검색 시 계산되는 관련도 점수, 컬럼 아님
 	User            *User     `json:"user,omitempty" gorm:"foreignKey:UserID"`
 	Room            *Room     `json:"room,omitempty" gorm:"foreignKey:RoomID"`
 	Comments        []Comment `json:"comments,omitempty" gorm:"foreignKey:MemoID;constraint:OnDelete:CASCADE"`
+	Tags            []Tag     `json:"tags,omitempty" gorm:"many2many:memo_tags;joinForeignKey:MemoID;joinReferences:TagID"`
+	Images          []MemoImage `json:"images,omitempty" gorm:"foreignKey:MemoID;constraint:OnDelete:CASCADE"`
 }
 
 // TableName Memo 테이블명 지정
@@ -66,18 +145,138 @@ func (Memo) TableName() string {
 	return "memos"
 }
 
+// Tag 태그 테이블 (방 단위로 관리)
+type Tag struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	RoomID    uint      `json:"room_id" gorm:"column:room_id;not null;uniqueIndex:idx_room_tag_name;comment:태그가 속한 방 ID"`
+	Name      string    `json:"name" gorm:"column:name;type:varchar(50);not null;uniqueIndex:idx_room_tag_name;comment:태그 이름"`
+	CreatedAt time.Time `json:"created_at"`
+	Room      *Room     `json:"room,omitempty" gorm:"foreignKey:RoomID"`
+}
+
+// TableName Tag 테이블명 지정
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// MemoTag 메모-태그 연결 테이블
+type MemoTag struct {
+	MemoID uint `json:"memo_id" gorm:"column:memo_id;primaryKey;comment:메모 ID"`
+	TagID  uint `json:"tag_id" gorm:"column:tag_id;primaryKey;index;comment:태그 ID"`
+}
+
+// TableName MemoTag 테이블명 지정
+func (MemoTag) TableName() string {
+	return "memo_tags"
+}
+
+// MemoImage 메모 이미지 테이블 (sort_order가 가장 작은 이미지가 대표 이미지로 memos.image_key에 저장됨)
+type MemoImage struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	MemoID    uint      `json:"memo_id" gorm:"column:memo_id;not null;index:idx_memo_sort;comment:메모 ID"`
+	ObjectKey string    `json:"object_key" gorm:"column:object_key;type:varchar(500);not null;comment:저장소 객체 키 (원본 크기)"`
+	HasVariants bool    `json:"has_variants" gorm:"column:has_variants;not null;default:false;comment:중간 크기/썸네일 이미지 존재 여부"`
+	SortOrder int       `json:"sort_order" gorm:"column:sort_order;not null;default:0;index:idx_memo_sort;comment:정렬 순서 (0이 대표 이미지)"`
+	Caption   *string   `json:"caption,omitempty" gorm:"column:caption;type:varchar(255);comment:이미지 설명"`
+	Width     int       `json:"width" gorm:"column:width;not null;default:0;comment:이미지 너비(px)"`
+	Height    int       `json:"height" gorm:"column:height;not null;default:0;comment:이미지 높이(px)"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName MemoImage 테이블명 지정
+func (MemoImage) TableName() string {
+	return "memo_images"
+}
+
 // Comment 댓글 정보 테이블
 type Comment struct {
 	gorm.Model
-	MemoID   uint   `json:"memo_id" gorm:"column:memo_id;not null;index;comment:메모 ID"`
-	UserID   uint   `json:"user_id" gorm:"column:user_id;not null;index;comment:작성자 ID"`
-	Content  string `json:"content" gorm:"column:content;type:text;not null;comment:댓글 내용"`
-	Rating   uint8  `json:"rating" gorm:"column:rating;type:tinyint unsigned;default:0;comment:댓글 작성자의 평점 (0-5)"`
-	Memo     *Memo  `json:"memo,omitempty" gorm:"foreignKey:MemoID"`
-	User     *User  `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	MemoID          uint   `json:"memo_id" gorm:"column:memo_id;not null;index;comment:메모 ID"`
+	UserID          uint   `json:"user_id" gorm:"column:user_id;not null;index;comment:작성자 ID"`
+	Content         string `json:"content" gorm:"column:content;type:text;not null;comment:댓글 내용"`
+	Rating          uint8  `json:"rating" gorm:"column:rating;type:tinyint unsigned;default:0;comment:댓글 작성자의 평점 (0-5)"`
+	DeletedWithMemo bool   `json:"-" gorm:"column:deleted_with_memo;not null;default:false;comment:메모를 휴지통으로 옮길 때 함께 삭제된 댓글 (메모 복원 시 함께 복원)"`
+	Memo            *Memo  `json:"memo,omitempty" gorm:"foreignKey:MemoID"`
+	User            *User  `json:"user,omitempty" gorm:"foreignKey:UserID"`
 }
 
 // TableName Comment 테이블명 지정
 func (Comment) TableName() string {
 	return "comments"
 }
+
+// MemoRevision 메모 수정 이력 테이블 (수정 전후 메모 필드 스냅샷, 이미지는 포함하지 않음)
+type MemoRevision struct {
+	ID            uint      `json:"id" gorm:"primarykey"`
+	MemoID        uint      `json:"memo_id" gorm:"column:memo_id;not null;index:idx_memo_revision;comment:메모 ID"`
+	EditorID      uint      `json:"editor_id" gorm:"column:editor_id;not null;index;comment:수정한 사용자 ID"`
+	ChangedFields string    `json:"changed_fields" gorm:"column:changed_fields;type:varchar(500);not null;comment:변경된 필드 (쉼표로 구분)"`
+	Before        string    `json:"before" gorm:"column:before_snapshot;type:json;not null;comment:수정 전 스냅샷"`
+	After         string    `json:"after" gorm:"column:after_snapshot;type:json;not null;comment:수정 후 스냅샷"`
+	CreatedAt     time.Time `json:"created_at" gorm:"index:idx_memo_revision"`
+	Editor        *User     `json:"editor,omitempty" gorm:"foreignKey:EditorID"`
+}
+
+// TableName MemoRevision 테이블명 지정
+func (MemoRevision) TableName() string {
+	return "memo_revisions"
+}
+
+// RefreshToken 리프레시 토큰 발급 이력 테이블 (rotation 및 재사용 탐지용)
+type RefreshToken struct {
+	gorm.Model
+	TokenID    string     `json:"token_id" gorm:"column:token_id;type:varchar(50);uniqueIndex;not null;comment:토큰 고유 ID (jti)"`
+	FamilyID   string     `json:"family_id" gorm:"column:family_id;type:varchar(50);not null;index;comment:로그인 세션 단위 토큰 패밀리 ID"`
+	UserID     uint       `json:"user_id" gorm:"column:user_id;not null;index;comment:사용자 ID"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"column:expires_at;not null;comment:만료 시간"`
+	UsedAt     *time.Time `json:"used_at,omitempty" gorm:"column:used_at;comment:rotation에 사용된 시간"`
+	ReplacedBy *string    `json:"replaced_by,omitempty" gorm:"column:replaced_by;type:varchar(50);comment:rotation으로 새로 발급된 토큰 ID"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" gorm:"column:revoked_at;comment:폐기 시간 (로그아웃/재사용 탐지)"`
+	User       *User      `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName RefreshToken 테이블명 지정
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+// UploadPurpose presigned 업로드 용도
+type UploadPurpose string
+
+const (
+	UploadPurposeMemo    UploadPurpose = "memo"    // 메모 이미지
+	UploadPurposeProfile UploadPurpose = "profile" // 프로필 이미지
+)
+
+// IsValid 유효한 업로드 용도인지 확인
+func (p UploadPurpose) IsValid() bool {
+	return p == UploadPurposeMemo || p == UploadPurposeProfile
+}
+
+// UploadStatus presigned 업로드 상태
+type UploadStatus string
+
+const (
+	UploadStatusPending   UploadStatus = "pending"   // 업로드 URL 발급 후 완료 처리 전
+	UploadStatusCompleted UploadStatus = "completed" // 검증 후 메모/프로필에 연결됨
+)
+
+// Upload 클라이언트가 presigned URL로 저장소에 직접 올리는 업로드 (완료 처리 시 검증 후 메모/프로필에 연결)
+type Upload struct {
+	gorm.Model
+	UploadID    string        `json:"upload_id" gorm:"column:upload_id;type:varchar(50);uniqueIndex;not null;comment:업로드 고유 ID"`
+	UserID      uint          `json:"user_id" gorm:"column:user_id;not null;index;comment:업로드한 사용자 ID"`
+	Purpose     UploadPurpose `json:"purpose" gorm:"column:purpose;type:varchar(20);not null;comment:용도 (memo/profile)"`
+	ObjectKey   string        `json:"object_key" gorm:"column:object_key;type:varchar(500);not null;comment:저장소 객체 키"`
+	ContentType string        `json:"content_type" gorm:"column:content_type;type:varchar(100);not null;comment:업로드할 Content-Type"`
+	Size        int64         `json:"size" gorm:"column:size;not null;comment:요청한 파일 크기 (bytes, 업로드된 파일은 이보다 클 수 없음)"`
+	Status      UploadStatus  `json:"status" gorm:"column:status;type:varchar(20);not null;default:pending;index:idx_status_expires;comment:상태 (pending/completed)"`
+	ExpiresAt   time.Time     `json:"expires_at" gorm:"column:expires_at;not null;index:idx_status_expires;comment:완료 처리 기한"`
+	CompletedAt *time.Time    `json:"completed_at,omitempty" gorm:"column:completed_at;comment:완료 처리 시간"`
+}
+
+// TableName Upload 테이블명 지정
+func (Upload) TableName() string {
+	return "uploads"
+}
@@ -0,0 +1,19 @@
+-- Migration: Add deleted_with_memo to comments
+-- Created: 2026-10-18
+-- Description: 메모를 휴지통으로 옮길 때 함께 삭제된 댓글 표시 컬럼 추가
+--              메모를 복원하면 이 컬럼이 1인 댓글만 함께 복원 (메모 삭제 전에 따로 삭제한 댓글은 복원하지 않음)
+
+USE daily_dev;
+
+ALTER TABLE comments
+    ADD COLUMN deleted_with_memo TINYINT(1) NOT NULL DEFAULT 0 COMMENT '메모와 함께 삭제된 댓글 (메모 복원 시 함께 복원)' AFTER rating;
+
+-- 이미 휴지통에 있는 메모의 댓글 중 메모와 같은 시각에 삭제된 댓글 표시
+UPDATE comments c
+JOIN memos m ON m.id = c.memo_id
+SET c.deleted_with_memo = 1
+WHERE m.deleted_at IS NOT NULL
+  AND c.deleted_at = m.deleted_at;
+
+-- Rollback:
+-- ALTER TABLE comments DROP COLUMN deleted_with_memo;
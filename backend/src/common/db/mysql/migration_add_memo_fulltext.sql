@@ -0,0 +1,13 @@
+-- Migration: Add FULLTEXT search index to memos
+-- Created: 2026-10-18
+-- Description: n-gram FULLTEXT index (Korean support) for GET /v0.1/memo/search
+--              over title, content, location_name, business_name, business_address
+--              (server ngram_token_size default 2 = 두 글자 이상 검색)
+
+USE daily_dev;
+
+ALTER TABLE memos
+ADD FULLTEXT INDEX ft_memos_search (title, content, location_name, business_name, business_address) WITH PARSER ngram;
+
+-- Rollback:
+-- ALTER TABLE memos DROP INDEX ft_memos_search;
@@ -0,0 +1,21 @@
+-- Migration: Add geohash column to memos for nearby / bounding-box queries
+-- Created: 2026-10-18
+-- Description: geohash prefix index for GET /v0.1/memo/nearby and /v0.1/memo/bbox
+--              (application keeps it in sync with latitude/longitude on create/update)
+
+USE daily_dev;
+
+ALTER TABLE memos
+ADD COLUMN geohash VARCHAR(12) NULL COMMENT '위치 geohash (위도/경도와 함께 갱신)' AFTER longitude;
+
+CREATE INDEX idx_memos_geohash ON memos(geohash);
+
+-- 기존 메모 geohash 채우기
+UPDATE memos
+SET geohash = ST_GeoHash(longitude, latitude, 12)
+WHERE latitude IS NOT NULL AND longitude IS NOT NULL
+  AND latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180;
+
+-- Rollback:
+-- DROP INDEX idx_memos_geohash ON memos;
+-- ALTER TABLE memos DROP COLUMN geohash;
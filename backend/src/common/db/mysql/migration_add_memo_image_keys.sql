@@ -0,0 +1,36 @@
+-- Migration: Store object keys instead of URLs for memo images
+-- Created: 2026-10-18
+-- Description: 메모 이미지는 저장소 객체 키만 저장하고 URL은 응답 시 현재 저장소 설정(CDN, 커스텀 엔드포인트)으로 생성
+--              S3 주소로 저장된 기존 URL은 키로 변환, 키를 알 수 없는 외부 URL은 그대로 유지
+
+USE daily_dev;
+
+-- 1. memo_images: s3_key -> object_key, URL 컬럼 대신 크기별 이미지 존재 여부 저장
+ALTER TABLE memo_images
+    CHANGE COLUMN s3_key object_key VARCHAR(500) NOT NULL COMMENT '저장소 객체 키 (원본 크기)',
+    ADD COLUMN has_variants TINYINT(1) NOT NULL DEFAULT 0 COMMENT '중간 크기/썸네일 이미지 존재 여부' AFTER object_key;
+
+UPDATE memo_images SET has_variants = 1 WHERE medium_url IS NOT NULL OR thumbnail_url IS NOT NULL;
+
+ALTER TABLE memo_images
+    DROP COLUMN thumbnail_url,
+    DROP COLUMN medium_url,
+    DROP COLUMN image_url;
+
+-- 2. memos: 대표 이미지 키 컬럼 추가 (image_url은 외부 이미지 URL 용도로만 유지)
+ALTER TABLE memos
+    ADD COLUMN image_key VARCHAR(500) NOT NULL DEFAULT '' COMMENT '대표 이미지 저장소 객체 키' AFTER image_url;
+
+UPDATE memos
+SET image_key = SUBSTRING_INDEX(image_url, '.amazonaws.com/', -1),
+    image_url = ''
+WHERE image_url LIKE 'https://%.amazonaws.com/%';
+
+-- Rollback:
+-- ALTER TABLE memos DROP COLUMN image_key;  -- image_url 복원이 필요하면 삭제 전에 버킷 URL로 다시 채울 것
+-- ALTER TABLE memo_images
+--     ADD COLUMN image_url VARCHAR(500) NOT NULL DEFAULT '' AFTER object_key,
+--     ADD COLUMN medium_url VARCHAR(500) NULL AFTER image_url,
+--     ADD COLUMN thumbnail_url VARCHAR(500) NULL AFTER medium_url,
+--     DROP COLUMN has_variants,
+--     CHANGE COLUMN object_key s3_key VARCHAR(500) NOT NULL COMMENT 'S3 객체 키';
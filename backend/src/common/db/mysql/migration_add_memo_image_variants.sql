@@ -0,0 +1,12 @@
+-- Migration: Add resized image variants to memo images
+-- Created: 2026-10-18
+-- Description: 업로드 시 생성되는 중간 크기/썸네일 이미지 URL 컬럼 추가 (기존 이미지는 NULL, 원본 URL 사용)
+
+USE daily_dev;
+
+ALTER TABLE memo_images
+    ADD COLUMN medium_url VARCHAR(500) NULL COMMENT '중간 크기 이미지 URL (긴 변 1280px)' AFTER image_url,
+    ADD COLUMN thumbnail_url VARCHAR(500) NULL COMMENT '썸네일 이미지 URL (긴 변 320px)' AFTER medium_url;
+
+-- Rollback:
+-- ALTER TABLE memo_images DROP COLUMN thumbnail_url, DROP COLUMN medium_url;
@@ -0,0 +1,31 @@
+-- Migration: Add multiple images per memo
+-- Created: 2026-10-18
+-- Description: memo_images table (정렬 순서, 설명, 크기, S3 키). memos.image_url은 대표 이미지로 유지
+
+USE daily_dev;
+
+-- 1. Memo Images Table: 메모별 여러 이미지
+CREATE TABLE IF NOT EXISTS memo_images (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    memo_id BIGINT UNSIGNED NOT NULL COMMENT '메모 ID',
+    s3_key VARCHAR(500) NOT NULL COMMENT 'S3 객체 키',
+    image_url VARCHAR(500) NOT NULL COMMENT '이미지 URL',
+    sort_order INT NOT NULL DEFAULT 0 COMMENT '정렬 순서 (0이 대표 이미지)',
+    caption VARCHAR(255) NULL COMMENT '이미지 설명',
+    width INT NOT NULL DEFAULT 0 COMMENT '이미지 너비(px)',
+    height INT NOT NULL DEFAULT 0 COMMENT '이미지 높이(px)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '생성 시간',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정 시간',
+    FOREIGN KEY (memo_id) REFERENCES memos(id) ON DELETE CASCADE,
+    INDEX idx_memo_sort (memo_id, sort_order)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='메모 이미지 테이블';
+
+-- 2. 기존 메모의 단일 이미지를 대표 이미지로 이관
+INSERT INTO memo_images (memo_id, s3_key, image_url, sort_order)
+SELECT id, SUBSTRING_INDEX(image_url, '.amazonaws.com/', -1), image_url, 0
+FROM memos
+WHERE image_url IS NOT NULL AND image_url <> ''
+  AND NOT EXISTS (SELECT 1 FROM memo_images mi WHERE mi.memo_id = memos.id);
+
+-- Rollback:
+-- DROP TABLE IF EXISTS memo_images;
@@ -0,0 +1,15 @@
+-- Migration: Add indexes for memo list cursor pagination
+-- Created: 2026-10-18
+-- Description: Support keyset pagination on GET /v0.1/memo ordered by
+--              created_at / updated_at / rating within the user's rooms
+
+USE daily_dev;
+
+CREATE INDEX idx_memos_room_created ON memos(room_id, is_pinned, created_at, id);
+CREATE INDEX idx_memos_room_updated ON memos(room_id, is_pinned, updated_at, id);
+CREATE INDEX idx_memos_room_rating ON memos(room_id, is_pinned, rating, id);
+
+-- Rollback:
+-- DROP INDEX idx_memos_room_created ON memos;
+-- DROP INDEX idx_memos_room_updated ON memos;
+-- DROP INDEX idx_memos_room_rating ON memos;
@@ -0,0 +1,23 @@
+-- Migration: Add memo_revisions table for memo edit history
+-- Created: 2026-10-18
+-- Description: 메모 수정(PUT/PATCH/롤백)마다 수정한 사용자, 시각, 변경된 필드와 수정 전후 스냅샷을 기록
+--              필드 단위 diff 조회와 과거 이력으로 롤백에 사용 (이미지는 스냅샷에 포함하지 않음)
+
+USE daily_dev;
+
+CREATE TABLE IF NOT EXISTS memo_revisions (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    memo_id BIGINT UNSIGNED NOT NULL COMMENT '메모 ID',
+    editor_id BIGINT UNSIGNED NOT NULL COMMENT '수정한 사용자 ID',
+    changed_fields VARCHAR(500) NOT NULL COMMENT '변경된 필드 (쉼표로 구분)',
+    before_snapshot JSON NOT NULL COMMENT '수정 전 스냅샷',
+    after_snapshot JSON NOT NULL COMMENT '수정 후 스냅샷',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '수정 시간',
+    FOREIGN KEY (memo_id) REFERENCES memos(id) ON DELETE CASCADE,
+    FOREIGN KEY (editor_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_memo_revision (memo_id, created_at),
+    INDEX idx_editor_id (editor_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='메모 수정 이력 테이블';
+
+-- Rollback:
+-- DROP TABLE IF EXISTS memo_revisions;
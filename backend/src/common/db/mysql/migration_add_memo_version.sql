@@ -0,0 +1,12 @@
+-- Migration: Add version to memos
+-- Created: 2026-10-18
+-- Description: 메모 버전 컬럼 추가 (메모, 태그, 이미지, 댓글 평점이 바뀔 때마다 1 증가)
+--              GET /v0.1/memo/:id의 ETag로 내려주고 PUT/PATCH/DELETE의 If-Match와 비교해 동시 수정을 막음
+
+USE daily_dev;
+
+ALTER TABLE memos
+    ADD COLUMN version INT UNSIGNED NOT NULL DEFAULT 1 COMMENT '메모 버전 (수정할 때마다 1 증가, ETag/If-Match 동시 수정 확인용)' AFTER naver_place_url;
+
+-- Rollback:
+-- ALTER TABLE memos DROP COLUMN version;
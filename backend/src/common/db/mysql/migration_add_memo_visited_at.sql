@@ -0,0 +1,12 @@
+-- Migration: Add visited_at to memos
+-- Created: 2026-10-18
+-- Description: 방문 일시 컬럼 추가 (직접 입력하거나 사진 EXIF 촬영 시각으로 채움)
+
+USE daily_dev;
+
+ALTER TABLE memos
+    ADD COLUMN visited_at TIMESTAMP NULL DEFAULT NULL COMMENT '방문 일시 (사진 촬영 시각으로 채울 수 있음)' AFTER category,
+    ADD INDEX idx_memos_visited_at (visited_at);
+
+-- Rollback:
+-- ALTER TABLE memos DROP INDEX idx_memos_visited_at, DROP COLUMN visited_at;
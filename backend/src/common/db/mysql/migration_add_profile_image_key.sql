@@ -0,0 +1,14 @@
+-- Migration: Add profile_image_key to users
+-- Created: 2026-10-18
+-- Description: 서버가 저장한 프로필 이미지의 객체 키와 크기별 이미지 여부를 별도로 저장
+--              이미지 교체 시 profile_image_url(클라이언트가 외부 URL로 바꿀 수 있음)이 아닌 이 키의 객체만 삭제
+--              기존 사용자는 빈 값으로 두며, 더 이상 참조되지 않는 기존 파일은 이미지 정리 작업에서 삭제
+
+USE daily_dev;
+
+ALTER TABLE users
+    ADD COLUMN profile_image_key VARCHAR(500) NOT NULL DEFAULT '' COMMENT '서버가 저장한 프로필 이미지 객체 키 (이미지 교체 시 이 키만 삭제)' AFTER profile_image_url,
+    ADD COLUMN profile_image_has_variants TINYINT(1) NOT NULL DEFAULT 0 COMMENT '프로필 이미지의 크기별 이미지 존재 여부' AFTER profile_image_key;
+
+-- Rollback:
+-- ALTER TABLE users DROP COLUMN profile_image_has_variants, DROP COLUMN profile_image_key;
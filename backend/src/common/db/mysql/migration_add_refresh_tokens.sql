@@ -0,0 +1,27 @@
+-- Migration: Add refresh_tokens table for refresh token rotation
+-- Created: 2026-10-18
+-- Description: Persist issued refresh tokens so they can be rotated, revoked on logout
+--              and a reused (already rotated) token can revoke its whole token family
+
+USE daily_dev;
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    token_id VARCHAR(50) NOT NULL UNIQUE COMMENT '토큰 고유 ID (jti)',
+    family_id VARCHAR(50) NOT NULL COMMENT '로그인 세션 단위 토큰 패밀리 ID',
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '사용자 ID',
+    expires_at TIMESTAMP NOT NULL COMMENT '만료 시간',
+    used_at TIMESTAMP NULL DEFAULT NULL COMMENT 'rotation에 사용된 시간',
+    replaced_by VARCHAR(50) NULL COMMENT 'rotation으로 새로 발급된 토큰 ID',
+    revoked_at TIMESTAMP NULL DEFAULT NULL COMMENT '폐기 시간 (로그아웃/재사용 탐지)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '생성 시간',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정 시간',
+    deleted_at TIMESTAMP NULL DEFAULT NULL COMMENT '삭제 시간 (soft delete)',
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_family_id (family_id),
+    INDEX idx_user_id (user_id),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='리프레시 토큰 테이블';
+
+-- Rollback:
+-- DROP TABLE IF EXISTS refresh_tokens;
@@ -0,0 +1,20 @@
+-- Migration: Add room kick records
+-- Created: 2026-10-18
+-- Description: 방 강퇴 기록 테이블 추가 (기록이 있으면 초대 코드나 방 고유 코드로 다시 참여할 수 없음)
+--              소유자가 강퇴를 취소하면 행을 삭제
+
+USE daily_dev;
+
+CREATE TABLE IF NOT EXISTS room_kicks (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    room_id BIGINT UNSIGNED NOT NULL COMMENT '방 ID',
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '강퇴된 사용자 ID',
+    kicked_by_user_id BIGINT UNSIGNED NOT NULL COMMENT '강퇴한 사용자 ID',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '강퇴 시간',
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE INDEX idx_room_user (room_id, user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='방 강퇴 기록 테이블';
+
+-- Rollback:
+-- DROP TABLE IF EXISTS room_kicks;
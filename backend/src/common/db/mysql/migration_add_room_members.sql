@@ -0,0 +1,47 @@
+-- Migration: Add room membership and invite codes
+-- Created: 2026-10-18
+-- Description: Add room_members (owner/editor/viewer) and room_invites tables,
+--              and register every existing room owner as an owner member
+
+USE daily_dev;
+
+-- 1. Room Members Table: 방 멤버 및 권한 관리
+CREATE TABLE IF NOT EXISTS room_members (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    room_id BIGINT UNSIGNED NOT NULL COMMENT '방 ID',
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '사용자 ID',
+    role VARCHAR(20) NOT NULL DEFAULT 'editor' COMMENT '권한 (owner/editor/viewer)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '참여 시간',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정 시간',
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE INDEX idx_room_user (room_id, user_id),
+    INDEX idx_user_id (user_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='방 멤버 테이블';
+
+-- 2. Room Invites Table: 만료 시간이 있는 초대 코드
+CREATE TABLE IF NOT EXISTS room_invites (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    code VARCHAR(20) NOT NULL UNIQUE COMMENT '초대 코드',
+    room_id BIGINT UNSIGNED NOT NULL COMMENT '방 ID',
+    role VARCHAR(20) NOT NULL DEFAULT 'editor' COMMENT '참여 시 부여할 권한',
+    created_by_user_id BIGINT UNSIGNED NOT NULL COMMENT '초대 코드 생성자 ID',
+    expires_at TIMESTAMP NOT NULL COMMENT '만료 시간',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '생성 시간',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정 시간',
+    deleted_at TIMESTAMP NULL DEFAULT NULL COMMENT '삭제 시간 (soft delete)',
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    INDEX idx_room_id (room_id),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='방 초대 코드 테이블';
+
+-- 3. 기존 방 소유자를 owner 멤버로 등록
+INSERT INTO room_members (room_id, user_id, role, created_at, updated_at)
+SELECT id, owner_user_id, 'owner', created_at, updated_at
+FROM rooms
+WHERE deleted_at IS NULL
+ON DUPLICATE KEY UPDATE role = 'owner';
+
+-- Rollback:
+-- DROP TABLE IF EXISTS room_invites;
+-- DROP TABLE IF EXISTS room_members;
@@ -0,0 +1,29 @@
+-- Migration: Add room-scoped tags for memos
+-- Created: 2026-10-18
+-- Description: tags (room 단위 태그) and memo_tags (메모-태그 연결) tables
+
+USE daily_dev;
+
+-- 1. Tags Table: 방 단위 태그
+CREATE TABLE IF NOT EXISTS tags (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    room_id BIGINT UNSIGNED NOT NULL COMMENT '태그가 속한 방 ID',
+    name VARCHAR(50) NOT NULL COMMENT '태그 이름',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '생성 시간',
+    FOREIGN KEY (room_id) REFERENCES rooms(id) ON DELETE CASCADE,
+    UNIQUE INDEX idx_room_tag_name (room_id, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='태그 테이블';
+
+-- 2. Memo Tags Table: 메모-태그 연결
+CREATE TABLE IF NOT EXISTS memo_tags (
+    memo_id BIGINT UNSIGNED NOT NULL COMMENT '메모 ID',
+    tag_id BIGINT UNSIGNED NOT NULL COMMENT '태그 ID',
+    PRIMARY KEY (memo_id, tag_id),
+    FOREIGN KEY (memo_id) REFERENCES memos(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE,
+    INDEX idx_tag_id (tag_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='메모-태그 연결 테이블';
+
+-- Rollback:
+-- DROP TABLE IF EXISTS memo_tags;
+-- DROP TABLE IF EXISTS tags;
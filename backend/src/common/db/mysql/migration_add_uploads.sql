@@ -0,0 +1,29 @@
+-- Migration: Add uploads table for direct-to-storage presigned uploads
+-- Created: 2026-10-18
+-- Description: 클라이언트가 presigned PUT URL로 저장소에 직접 올리는 업로드를 기록
+--              완료 처리 시 HeadObject로 크기/Content-Type을 검증한 뒤 메모 또는 프로필에 연결
+
+USE daily_dev;
+
+CREATE TABLE IF NOT EXISTS uploads (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    upload_id VARCHAR(50) NOT NULL UNIQUE COMMENT '업로드 고유 ID',
+    user_id BIGINT UNSIGNED NOT NULL COMMENT '업로드한 사용자 ID',
+    purpose VARCHAR(20) NOT NULL COMMENT '용도 (memo/profile)',
+    object_key VARCHAR(500) NOT NULL COMMENT '저장소 객체 키',
+    content_type VARCHAR(100) NOT NULL COMMENT '업로드할 Content-Type',
+    size BIGINT NOT NULL COMMENT '요청한 파일 크기 (bytes, 업로드된 파일은 이보다 클 수 없음)',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' COMMENT '상태 (pending/completed)',
+    expires_at TIMESTAMP NOT NULL COMMENT '완료 처리 기한',
+    completed_at TIMESTAMP NULL DEFAULT NULL COMMENT '완료 처리 시간',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '생성 시간',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '수정 시간',
+    deleted_at TIMESTAMP NULL DEFAULT NULL COMMENT '삭제 시간 (soft delete)',
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    INDEX idx_user_id (user_id),
+    INDEX idx_status_expires (status, expires_at),
+    INDEX idx_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='presigned 업로드 테이블';
+
+-- Rollback:
+-- DROP TABLE IF EXISTS uploads;
@@ -27,12 +27,17 @@ type Config struct {
 	DBPassword string
 
 	// JWT Configuration
-	JWTSecret      string
-	JWTExpireHours int
+	JWTSecret        string
+	JWTRefreshSecret string
+	JWTExpireHours   int
 
 	// File Upload Configuration
-	UploadPath  string
-	MaxFileSize int64
+	UploadPath           string
+	MaxFileSize          int64
+	MaxImagePixels       int64  // 업로드 이미지 최대 픽셀 수 (너비 x 높이)
+	StorageDriver        string // s3 | local (비어 있으면 로컬 환경에서 AWS 키가 없거나 S3 초기화에 실패할 때 local)
+	LocalStorageBaseURL  string // local 저장소 파일 URL의 서버 주소
+	StorageSigningSecret string // local 저장소 presigned URL 서명 키 (비어 있으면 JWT_SECRET에서 파생)
 
 	// AWS S3 Configuration
 	AWSRegion          string
@@ -40,6 +45,18 @@ type Config struct {
 	AWSSecretAccessKey string
 	S3BucketName       string
 	S3Endpoint         string // Optional: for MinIO or custom S3-compatible services
+	CDNBaseURL         string // Optional: CDN/CloudFront base URL for public object URLs
+
+	// Image GC Configuration (DB에서 참조하지 않는 이미지 파일 정리)
+	ImageGCEnabled       bool
+	ImageGCDryRun        bool // true면 삭제하지 않고 삭제 대상만 보고
+	ImageGCIntervalHours int
+	ImageGCGraceHours    int // 업로드 후 이 시간이 지나지 않은 파일은 삭제하지 않음 (최소 1시간)
+
+	// Memo Trash Configuration (휴지통 메모 영구 삭제)
+	MemoTrashPurgeEnabled       bool
+	MemoTrashRetentionDays      int // 휴지통으로 옮긴 뒤 이 기간이 지나면 댓글, 이미지와 함께 영구 삭제
+	MemoTrashPurgeIntervalHours int
 
 	// CORS Configuration
 	AllowedOrigins []string
@@ -74,9 +91,11 @@ func InitVarNames() []string {
 	result = append(result, "DB_USER")
 	result = append(result, "DB_PASSWORD")
 	result = append(result, "JWT_SECRET")
+	result = append(result, "JWT_REFRESH_SECRET")
 	result = append(result, "JWT_EXPIRE_HOURS")
 	result = append(result, "UPLOAD_PATH")
 	result = append(result, "MAX_FILE_SIZE")
+	result = append(result, "STORAGE_DRIVER")
 	result = append(result, "ALLOWED_ORIGINS")
 	result = append(result, "LOG_LEVEL")
 	result = append(result, "LOG_FILE")
@@ -106,19 +125,36 @@ func LoadConfig() error {
 		DBPassword: getEnv("DB_PASSWORD", "examplepassword"),
 
 		// JWT Configuration
-		JWTSecret:      getEnv("JWT_SECRET", "play-daily-secret-key-2024"),
-		JWTExpireHours: getEnvAsInt("JWT_EXPIRE_HOURS", 24),
+		JWTSecret:        getEnv("JWT_SECRET", "play-daily-secret-key-2024"),
+		JWTRefreshSecret: getEnv("JWT_REFRESH_SECRET", ""), // 비어있으면 JWT_SECRET에서 파생
+		JWTExpireHours:   getEnvAsInt("JWT_EXPIRE_HOURS", 24),
 
 		// File Upload Configuration
-		UploadPath:  getEnv("UPLOAD_PATH", "./uploads"),
-		MaxFileSize: getEnvAsInt64("MAX_FILE_SIZE", 10485760), // 10MB
+		UploadPath:           getEnv("UPLOAD_PATH", "./uploads"),
+		MaxFileSize:          getEnvAsInt64("MAX_FILE_SIZE", 10485760),    // 10MB
+		MaxImagePixels:       getEnvAsInt64("MAX_IMAGE_PIXELS", 50000000), // 50MP
+		StorageDriver:        getEnv("STORAGE_DRIVER", ""),
+		LocalStorageBaseURL:  getEnv("LOCAL_STORAGE_BASE_URL", ""),
+		StorageSigningSecret: getEnv("STORAGE_SIGNING_SECRET", ""), // 비어있으면 JWT_SECRET에서 파생
 
 		// AWS S3 Configuration
 		AWSRegion:          getEnv("AWS_REGION", "ap-south-1"),
 		AWSAccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
 		AWSSecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
 		S3BucketName:       getEnv("S3_BUCKET_NAME", "daily-memo-dev"),
-		S3Endpoint:         getEnv("S3_ENDPOINT", ""), // Optional
+		S3Endpoint:         getEnv("S3_ENDPOINT", ""),  // Optional
+		CDNBaseURL:         getEnv("CDN_BASE_URL", ""), // Optional
+
+		// Image GC Configuration
+		ImageGCEnabled:       getEnvAsBool("IMAGE_GC_ENABLED", true),
+		ImageGCDryRun:        getEnvAsBool("IMAGE_GC_DRY_RUN", true),
+		ImageGCIntervalHours: getEnvAsInt("IMAGE_GC_INTERVAL_HOURS", 6),
+		ImageGCGraceHours:    getEnvAsInt("IMAGE_GC_GRACE_HOURS", 24),
+
+		// Memo Trash Configuration
+		MemoTrashPurgeEnabled:       getEnvAsBool("MEMO_TRASH_PURGE_ENABLED", true),
+		MemoTrashRetentionDays:      getEnvAsInt("MEMO_TRASH_RETENTION_DAYS", 30),
+		MemoTrashPurgeIntervalHours: getEnvAsInt("MEMO_TRASH_PURGE_INTERVAL_HOURS", 24),
 
 		// CORS Configuration
 		AllowedOrigins: getEnvAsSlice("ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:5173"}),
@@ -195,6 +231,9 @@ func (c *Config) Print() {
 	fmt.Printf("Debug: %t\n", c.Debug)
 	fmt.Printf("Upload Path: %s\n", c.UploadPath)
 	fmt.Printf("Max File Size: %d bytes\n", c.MaxFileSize)
+	fmt.Printf("Storage Driver: %s\n", c.StorageDriver)
+	fmt.Printf("Image GC: enabled=%t, dryRun=%t, every %dh, grace %dh\n", c.ImageGCEnabled, c.ImageGCDryRun, c.ImageGCIntervalHours, c.ImageGCGraceHours)
+	fmt.Printf("Memo Trash Purge: enabled=%t, retention %dd, every %dh\n", c.MemoTrashPurgeEnabled, c.MemoTrashRetentionDays, c.MemoTrashPurgeIntervalHours)
 	fmt.Printf("Allowed Origins: %v\n", c.AllowedOrigins)
 	fmt.Printf("===================\n")
 }
@@ -51,6 +51,12 @@ const (
 	ErrPartner        = ErrType("PARTNER")
 )
 
+// conditional request error (If-Match)
+const (
+	ErrPreconditionFailed   = ErrType("PRECONDITION_FAILED")
+	ErrPreconditionRequired = ErrType("PRECONDITION_REQUIRED")
+)
+
 // game error
 const (
 	ErrNotAllUsersReady = ErrType("NOT_ALL_USERS_READY")
@@ -72,6 +78,7 @@ const (
 	ErrRoomFull                 = ErrType("ROOM_FULL")
 	ErrPlayerStateFailed        = ErrType("PLAYER_STATE_CHANGE_FAILED")
 	ErrRoomUserNotFound         = ErrType("ROOM_USER_NOT_FOUND")
+	ErrRoomUserKicked           = ErrType("ROOM_USER_KICKED")
 	ErrWrongPassword            = ErrType("WRONG_PASSWORD")
 	ErrInvalidAuthCode          = ErrType("INVALID_AUTH_CODE")
 )
@@ -111,11 +118,18 @@ var ErrHttpCode = map[string]int{
 	"INVALID_AUTH_CODE":    http.StatusUnauthorized,
 
 	//403
-	"PARTNER": http.StatusForbidden,
+	"PARTNER":          http.StatusForbidden,
+	"ROOM_USER_KICKED": http.StatusForbidden,
 
 	//404
 	"NOT_FOUND": http.StatusNotFound,
 
+	//412
+	"PRECONDITION_FAILED": http.StatusPreconditionFailed,
+
+	//428
+	"PRECONDITION_REQUIRED": http.StatusPreconditionRequired,
+
 	//500
 	"INTERNAL_SERVER":            http.StatusInternalServerError,
 	"INTERNAL_DB":                http.StatusInternalServerError,
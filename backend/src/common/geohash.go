@@ -0,0 +1,108 @@
+package common
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	geohashBase32       = "0123456789bcdefghjkmnpqrstuvwxyz"
+	GeohashMaxPrecision = 12
+	// geohashMaxCoverCells 영역을 덮는 geohash 셀 최대 개수 (이보다 많아지면 더 낮은 정밀도 사용)
+	geohashMaxCoverCells = 16
+	// earthMetersPerDegree 위도 1도당 거리(m)
+	earthMetersPerDegree = 111320.0
+)
+
+// EncodeGeohash 위도/경도를 geohash 문자열로 변환 (MySQL ST_GeoHash와 동일한 결과)
+func EncodeGeohash(lat, lng float64, precision int) string {
+	if precision < 1 || precision > GeohashMaxPrecision {
+		precision = GeohashMaxPrecision
+	}
+
+	minLat, maxLat := -90.0, 90.0
+	minLng, maxLng := -180.0, 180.0
+
+	var b strings.Builder
+	bit, ch := 0, 0
+	even := true
+	for b.Len() < precision {
+		if even {
+			mid := (minLng + maxLng) / 2
+			if lng >= mid {
+				ch |= 1 << (4 - bit)
+				minLng = mid
+			} else {
+				maxLng = mid
+			}
+		} else {
+			mid := (minLat + maxLat) / 2
+			if lat >= mid {
+				ch |= 1 << (4 - bit)
+				minLat = mid
+			} else {
+				maxLat = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+		} else {
+			b.WriteByte(geohashBase32[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return b.String()
+}
+
+// GeohashCover 영역(남서~북동)을 덮는 geohash prefix 목록 반환
+// 셀 개수가 제한 이내인 가장 높은 정밀도를 사용하며, 영역이 너무 넓으면 nil 반환 (prefix 필터 없이 조회)
+func GeohashCover(minLat, minLng, maxLat, maxLng float64) []string {
+	for precision := GeohashMaxPrecision; precision >= 1; precision-- {
+		lngBits := (precision*5 + 1) / 2
+		latBits := precision * 5 / 2
+		cellWidth := 360.0 / math.Pow(2, float64(lngBits))
+		cellHeight := 180.0 / math.Pow(2, float64(latBits))
+
+		minX := int(math.Floor((minLng + 180) / cellWidth))
+		maxX := int(math.Floor((math.Min(maxLng, 180-1e-9) + 180) / cellWidth))
+		minY := int(math.Floor((minLat + 90) / cellHeight))
+		maxY := int(math.Floor((math.Min(maxLat, 90-1e-9) + 90) / cellHeight))
+
+		if (maxX-minX+1)*(maxY-minY+1) > geohashMaxCoverCells {
+			continue
+		}
+
+		prefixes := make([]string, 0, (maxX-minX+1)*(maxY-minY+1))
+		for y := minY; y <= maxY; y++ {
+			for x := minX; x <= maxX; x++ {
+				centerLat := -90 + (float64(y)+0.5)*cellHeight
+				centerLng := -180 + (float64(x)+0.5)*cellWidth
+				prefixes = append(prefixes, EncodeGeohash(centerLat, centerLng, precision))
+			}
+		}
+		return prefixes
+	}
+
+	return nil
+}
+
+// BoundingBoxAround 중심 좌표와 반경(m)을 포함하는 영역(남서~북동) 계산
+func BoundingBoxAround(lat, lng, radiusM float64) (minLat, minLng, maxLat, maxLng float64) {
+	latDelta := radiusM / earthMetersPerDegree
+	minLat = math.Max(lat-latDelta, -90)
+	maxLat = math.Min(lat+latDelta, 90)
+
+	cosLat := math.Cos(lat * math.Pi / 180)
+	if cosLat < 1e-6 {
+		// 극지방은 경도 전체
+		return minLat, -180, maxLat, 180
+	}
+	lngDelta := radiusM / (earthMetersPerDegree * cosLat)
+	minLng = math.Max(lng-lngDelta, -180)
+	maxLng = math.Min(lng+lngDelta, 180)
+
+	return minLat, minLng, maxLat, maxLng
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestEncodeGeohash(t *testing.T) {
+	tests := []struct {
+		lat, lng  float64
+		precision int
+		want      string
+	}{
+		{lat: 57.64911, lng: 10.40744, precision: 11, want: "u4pruydqqvj"},
+		{lat: 57.64911, lng: 10.40744, precision: 5, want: "u4pru"},
+		{lat: 42.6, lng: -5.6, precision: 5, want: "ezs42"},
+		{lat: 0, lng: 0, precision: 12, want: "s00000000000"},
+		{lat: -90, lng: -180, precision: 12, want: "000000000000"},
+		{lat: 90, lng: 180, precision: 12, want: "zzzzzzzzzzzz"},
+		{lat: 57.64911, lng: 10.40744, precision: 1, want: "u"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := EncodeGeohash(tt.lat, tt.lng, tt.precision); got != tt.want {
+				t.Fatalf("EncodeGeohash(%v, %v, %d) = %q, want %q", tt.lat, tt.lng, tt.precision, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeGeohashPrecisionOutOfRange(t *testing.T) {
+	for _, precision := range []int{-1, 0, GeohashMaxPrecision + 1} {
+		if got := EncodeGeohash(57.64911, 10.40744, precision); len(got) != GeohashMaxPrecision {
+			t.Fatalf("EncodeGeohash(precision=%d) = %q, want %d characters", precision, got, GeohashMaxPrecision)
+		}
+	}
+}
+
+func TestGeohashCover(t *testing.T) {
+	tests := []struct {
+		name                           string
+		minLat, minLng, maxLat, maxLng float64
+	}{
+		{name: "city", minLat: 37.55, minLng: 126.95, maxLat: 37.58, maxLng: 127.01},
+		{name: "neighborhood", minLat: 37.5651, minLng: 126.9770, maxLat: 37.5679, maxLng: 126.9802},
+		{name: "across equator and prime meridian", minLat: -0.3, minLng: -0.4, maxLat: 0.2, maxLng: 0.1},
+		{name: "country", minLat: 33.0, minLng: 124.5, maxLat: 38.7, maxLng: 131.0},
+		{name: "north east corner", minLat: 89.5, minLng: 179.5, maxLat: 90, maxLng: 180},
+		{name: "south west corner", minLat: -90, minLng: -180, maxLat: -89.9, maxLng: -179.9},
+		{name: "single point", minLat: 57.64911, minLng: 10.40744, maxLat: 57.64911, maxLng: 10.40744},
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefixes := GeohashCover(tt.minLat, tt.minLng, tt.maxLat, tt.maxLng)
+			if len(prefixes) == 0 || len(prefixes) > geohashMaxCoverCells {
+				t.Fatalf("GeohashCover() returned %d prefixes, want 1..%d", len(prefixes), geohashMaxCoverCells)
+			}
+
+			// 모서리와 영역 안의 임의 좌표가 모두 반환된 prefix 중 하나로 시작해야 함
+			points := [][2]float64{
+				{tt.minLat, tt.minLng}, {tt.minLat, tt.maxLng},
+				{tt.maxLat, tt.minLng}, {tt.maxLat, tt.maxLng},
+			}
+			for i := 0; i < 1000; i++ {
+				points = append(points, [2]float64{
+					tt.minLat + rng.Float64()*(tt.maxLat-tt.minLat),
+					tt.minLng + rng.Float64()*(tt.maxLng-tt.minLng),
+				})
+			}
+
+			for _, p := range points {
+				hash := EncodeGeohash(p[0], p[1], GeohashMaxPrecision)
+				if !hasAnyPrefix(hash, prefixes) {
+					t.Fatalf("point (%v, %v) geohash %q is not covered by %v", p[0], p[1], hash, prefixes)
+				}
+			}
+		})
+	}
+}
+
+func TestGeohashCoverTooWide(t *testing.T) {
+	if prefixes := GeohashCover(-90, -180, 90, 180); prefixes != nil {
+		t.Fatalf("GeohashCover(whole world) = %v, want nil", prefixes)
+	}
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"fmt"
 	"main/common/db/mysql"
 	"main/common/storage"
@@ -22,16 +24,10 @@ func InitServer() error {
 		return err
 	}
 
-	// S3 초기화 (AWS 자격 증명이 없어도 에러로 처리하지 않음)
-	s3Config := storage.S3Config{
-		Region:          Env.AWSRegion,
-		AccessKeyID:     Env.AWSAccessKeyID,
-		SecretAccessKey: Env.AWSSecretAccessKey,
-		BucketName:      Env.S3BucketName,
-		Endpoint:        Env.S3Endpoint,
-	}
-	if err := storage.InitS3(s3Config); err != nil {
-		fmt.Printf("⚠️  S3 초기화 경고: %s (S3 업로드 기능 비활성화)\n", err.Error())
+	// 파일 저장소 초기화 (STORAGE_DRIVER를 지정하지 않은 로컬 환경에서만 S3 초기화에 실패하면 로컬 디스크 사용)
+	if err := initStorage(); err != nil {
+		fmt.Printf("storage 초기화 에러 : %s\n", err.Error())
+		return err
 	}
 
 	if !Env.IsLocal {
@@ -41,3 +37,71 @@ func InitServer() error {
 	}
 	return nil
 }
+
+// initStorage STORAGE_DRIVER 설정에 따라 storage.Default 초기화 (s3 | local)
+// 운영 환경이나 s3를 직접 지정한 경우 S3 초기화에 실패하면 에러 반환 (로컬 디스크로 바뀌어 업로드가 서버에만 저장되지 않도록)
+func initStorage() error {
+	// 모든 업로드 경로에서 같은 제한을 사용하도록 저장소 계층에 설정
+	storage.Limits.MaxFileSize = Env.MaxFileSize
+	storage.Limits.MaxPixels = Env.MaxImagePixels
+
+	driver := Env.StorageDriver
+	switch driver {
+	case "", "s3", "local":
+	default:
+		return fmt.Errorf("unknown STORAGE_DRIVER %q (s3 | local)", driver)
+	}
+	if driver == "" {
+		driver = "s3"
+		if Env.IsLocal && Env.AWSAccessKeyID == "" {
+			driver = "local"
+		}
+	}
+
+	if driver == "s3" {
+		s3Config := storage.S3Config{
+			Region:          Env.AWSRegion,
+			AccessKeyID:     Env.AWSAccessKeyID,
+			SecretAccessKey: Env.AWSSecretAccessKey,
+			BucketName:      Env.S3BucketName,
+			Endpoint:        Env.S3Endpoint,
+			PublicBaseURL:   Env.CDNBaseURL,
+		}
+		s3Storage, err := storage.NewS3Storage(s3Config)
+		if err == nil {
+			storage.Default = s3Storage
+			return nil
+		}
+		if Env.StorageDriver != "" || !Env.IsLocal {
+			return fmt.Errorf("failed to initialize s3 storage: %w", err)
+		}
+		fmt.Printf("⚠️  S3 초기화 경고: %s (로컬 저장소 사용)\n", err.Error())
+	}
+
+	baseURL := Env.LocalStorageBaseURL
+	if baseURL == "" {
+		baseURL = fmt.Sprintf("http://%s:%s", Env.Host, Env.Port)
+	}
+	localStorage, err := storage.NewLocalStorage(storage.LocalConfig{
+		Root:          Env.UploadPath,
+		BaseURL:       baseURL,
+		Secret:        storageSigningSecret(),
+		MaxObjectSize: Env.MaxFileSize,
+	})
+	if err != nil {
+		return err
+	}
+	storage.Default = localStorage
+	return nil
+}
+
+// storageSigningSecret local 저장소 presigned URL 서명 키
+// JWT 서명 키를 그대로 쓰면 업로드 URL 서명과 토큰 서명이 같은 키를 공유하므로, 지정하지 않으면 JWT_SECRET에서 HMAC으로 파생한 키 사용
+func storageSigningSecret() string {
+	if Env.StorageSigningSecret != "" {
+		return Env.StorageSigningSecret
+	}
+	mac := hmac.New(sha256.New, []byte(Env.JWTSecret))
+	mac.Write([]byte("local-storage-presign"))
+	return string(mac.Sum(nil))
+}
@@ -24,19 +24,27 @@ const (
 )
 
 func InitJwt() error {
-	secret := "secret"
-	AccessTokenSecretKey = []byte(secret)
-	RefreshTokenSecretKey = []byte(secret)
+	if Env == nil || Env.JWTSecret == "" {
+		return fmt.Errorf("JWT_SECRET is not configured")
+	}
+	refreshSecret := Env.JWTRefreshSecret
+	if refreshSecret == "" {
+		// 액세스 토큰과 리프레시 토큰이 서로 교차 검증되지 않도록 별도 키 사용
+		refreshSecret = Env.JWTSecret + ":refresh"
+	}
+	AccessTokenSecretKey = []byte(Env.JWTSecret)
+	RefreshTokenSecretKey = []byte(refreshSecret)
 	return nil
 }
 
-func GenerateToken(email string, userID uint) (string, int64, string, int64, error) {
+// GenerateToken 액세스/리프레시 토큰 발급 (refreshTokenID는 리프레시 토큰의 jti로 저장되어 rotation 추적에 사용)
+func GenerateToken(email string, userID uint, refreshTokenID string) (string, int64, string, int64, error) {
 	now := time.Now()
 	accessToken, accessTknExpiredAt, err := GenerateAccessToken(email, now, userID)
 	if err != nil {
 		return "", 0, "", 0, err
 	}
-	refreshToken, refreshTknExpiredAt, err := GenerateRefreshToken(email, now, userID)
+	refreshToken, refreshTknExpiredAt, err := GenerateRefreshToken(email, now, userID, refreshTokenID)
 	if err != nil {
 		return "", 0, "", 0, err
 	}
@@ -65,13 +73,14 @@ func GenerateAccessToken(email string, now time.Time, userID uint) (string, int6
 	return accessToken, expiredAt, nil
 }
 
-func GenerateRefreshToken(email string, now time.Time, userID uint) (string, int64, error) {
+func GenerateRefreshToken(email string, now time.Time, userID uint, tokenID string) (string, int64, error) {
 	expiredAt := now.Add(time.Hour * RefreshTokenExpiredTime).Unix()
 	claims := &JwtCustomClaims{
 		TimeToEpochMillis(now),
 		userID,
 		email,
 		jwt.StandardClaims{
+			Id:        tokenID,
 			ExpiresAt: expiredAt,
 		},
 	}
@@ -118,3 +127,24 @@ func ParseToken(tokenString string) (uint, string, error) {
 	userID := claims.UserID
 	return userID, email, nil
 }
+
+// ParseRefreshToken 리프레시 토큰 검증 후 claims 반환
+func ParseRefreshToken(tokenString string) (*JwtCustomClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JwtCustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method - %v", token.Header["alg"])
+		}
+		return RefreshTokenSecretKey, nil
+	})
+	if err != nil {
+		return nil, ErrorMsg(context.TODO(), ErrBadToken, Trace(), fmt.Sprintf("failed to parse refresh token - %v", err.Error()), ErrFromClient)
+	}
+	claims, ok := token.Claims.(*JwtCustomClaims)
+	if !ok || !token.Valid {
+		return nil, ErrorMsg(context.TODO(), ErrBadToken, Trace(), "invalid refresh token", ErrFromClient)
+	}
+	if claims.Id == "" || claims.UserID == 0 {
+		return nil, ErrorMsg(context.TODO(), ErrBadToken, Trace(), "refresh token has no token id", ErrFromClient)
+	}
+	return claims, nil
+}
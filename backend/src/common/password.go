@@ -0,0 +1,53 @@
+package common
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordHasher 비밀번호 해시 생성/검증 인터페이스
+type PasswordHasher interface {
+	// Hash 비밀번호 해시 생성
+	Hash(password string) (string, error)
+	// Verify 저장된 값과 입력 비밀번호 비교 (needsRehash: 레거시 평문 또는 낮은 cost로 저장되어 재해시가 필요한 경우)
+	Verify(stored string, password string) (match bool, needsRehash bool)
+}
+
+type BcryptHasher struct {
+	Cost int
+}
+
+func NewBcryptHasher() PasswordHasher {
+	return &BcryptHasher{
+		Cost: bcrypt.DefaultCost,
+	}
+}
+
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+func (h *BcryptHasher) Verify(stored string, password string) (bool, bool) {
+	// 해시 도입 이전에 평문으로 저장된 비밀번호
+	if !isBcryptHash(stored) {
+		match := subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+		return match, match
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)); err != nil {
+		return false, false
+	}
+
+	cost, err := bcrypt.Cost([]byte(stored))
+	return true, err != nil || cost < h.Cost
+}
+
+func isBcryptHash(value string) bool {
+	return len(value) == 60 && (strings.HasPrefix(value, "$2a$") || strings.HasPrefix(value, "$2b$") || strings.HasPrefix(value, "$2y$"))
+}
@@ -0,0 +1,297 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"time"
+)
+
+// ExifData 이미지에서 읽은 EXIF 정보 (없는 값은 기본값)
+type ExifData struct {
+	Orientation int        // 1-8, 없으면 1
+	Latitude    *float64   // GPS 위도
+	Longitude   *float64   // GPS 경도
+	TakenAt     *time.Time // 촬영 시각 (DateTimeOriginal, 시간대 정보가 없으면 서버 시간대)
+}
+
+const (
+	exifTagOrientation        = 0x0112
+	exifTagExifIFD            = 0x8769
+	exifTagGPSIFD             = 0x8825
+	exifTagDateTimeOriginal   = 0x9003
+	exifTagOffsetTimeOriginal = 0x9011
+	gpsTagLatitudeRef         = 0x0001
+	gpsTagLatitude            = 0x0002
+	gpsTagLongitudeRef        = 0x0003
+	gpsTagLongitude           = 0x0004
+)
+
+// ReadExif JPEG/PNG/WebP 파일에서 EXIF 정보 읽기 (EXIF가 없거나 손상되면 기본값 반환)
+func ReadExif(data []byte) ExifData {
+	exif := ExifData{Orientation: 1}
+
+	reader, ok := newTiffReader(exifPayload(data))
+	if !ok {
+		return exif
+	}
+
+	ifd0 := reader.readIFD(reader.firstIFDOffset())
+	if entry, ok := ifd0[exifTagOrientation]; ok {
+		if orientation := int(reader.uint16(entry.value)); orientation >= 1 && orientation <= 8 {
+			exif.Orientation = orientation
+		}
+	}
+
+	// 촬영 시각
+	if entry, ok := ifd0[exifTagExifIFD]; ok {
+		exifIFD := reader.readIFD(reader.uint32(entry.value))
+		if taken, ok := exifIFD[exifTagDateTimeOriginal]; ok {
+			offset := ""
+			if offsetEntry, ok := exifIFD[exifTagOffsetTimeOriginal]; ok {
+				offset = exifString(offsetEntry.value)
+			}
+			exif.TakenAt = parseExifTime(exifString(taken.value), offset)
+		}
+	}
+
+	// GPS 좌표 (0, 0은 위치 정보가 없는 것으로 간주)
+	if entry, ok := ifd0[exifTagGPSIFD]; ok {
+		gps := reader.readIFD(reader.uint32(entry.value))
+		lat, latOK := reader.gpsCoordinate(gps[gpsTagLatitude], exifString(gps[gpsTagLatitudeRef].value), "S")
+		lng, lngOK := reader.gpsCoordinate(gps[gpsTagLongitude], exifString(gps[gpsTagLongitudeRef].value), "W")
+		if latOK && lngOK && (lat != 0 || lng != 0) && lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180 {
+			exif.Latitude = &lat
+			exif.Longitude = &lng
+		}
+	}
+
+	return exif
+}
+
+// parseExifTime "2006:01:02 15:04:05" 형식의 EXIF 시각 (offset은 "+09:00" 형식, 없으면 서버 시간대)
+func parseExifTime(value string, offset string) *time.Time {
+	if offset != "" {
+		if t, err := time.Parse("2006:01:02 15:04:05-07:00", value+offset); err == nil {
+			return &t
+		}
+	}
+	t, err := time.ParseInLocation("2006:01:02 15:04:05", value, time.Local)
+	if err != nil || t.Year() < 1900 {
+		return nil
+	}
+	return &t
+}
+
+// exifString ASCII 값 (NUL 종료 문자 제거)
+func exifString(value []byte) string {
+	return strings.TrimSpace(strings.TrimRight(string(value), "\x00"))
+}
+
+// exifPayload 컨테이너 형식별 EXIF(TIFF) 블록 추출
+func exifPayload(data []byte) []byte {
+	switch {
+	case len(data) > 4 && data[0] == 0xFF && data[1] == 0xD8:
+		return jpegExif(data)
+	case len(data) > 8 && bytes.Equal(data[:8], []byte("\x89PNG\r\n\x1a\n")):
+		return pngExif(data)
+	case len(data) > 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return webpExif(data)
+	}
+	return nil
+}
+
+// jpegExif APP1 세그먼트의 EXIF 블록 (SOS 이전까지만 탐색)
+func jpegExif(data []byte) []byte {
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil
+		}
+		marker := data[pos+1]
+		if marker == 0xD8 || (marker >= 0xD0 && marker <= 0xD7) || marker == 0x01 || marker == 0xFF {
+			pos++
+			continue
+		}
+		if marker == 0xDA || marker == 0xD9 {
+			return nil
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil
+		}
+		segment := data[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return segment[6:]
+		}
+		pos = end
+	}
+	return nil
+}
+
+// pngExif eXIf 청크의 EXIF 블록
+func pngExif(data []byte) []byte {
+	pos := 8
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		chunkType := string(data[pos+4 : pos+8])
+		end := pos + 8 + length
+		if end+4 > len(data) {
+			return nil
+		}
+		if chunkType == "eXIf" {
+			return data[pos+8 : end]
+		}
+		if chunkType == "IDAT" || chunkType == "IEND" {
+			return nil
+		}
+		pos = end + 4 // CRC
+	}
+	return nil
+}
+
+// webpExif EXIF 청크의 EXIF 블록 (일부 인코더가 붙이는 "Exif\0\0" 접두어 제거)
+func webpExif(data []byte) []byte {
+	pos := 12
+	for pos+8 <= len(data) {
+		fourCC := string(data[pos : pos+4])
+		length := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + length
+		if end > len(data) {
+			return nil
+		}
+		if fourCC == "EXIF" {
+			return bytes.TrimPrefix(data[pos+8:end], []byte("Exif\x00\x00"))
+		}
+		pos = end + length%2 // 청크는 짝수 길이로 패딩됨
+	}
+	return nil
+}
+
+// tiffReader EXIF(TIFF) 블록의 IFD 항목 읽기
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+type ifdEntry struct {
+	typ   uint16
+	count uint32
+	value []byte // 값이 4바이트 이하이면 항목 안의 값, 아니면 offset이 가리키는 값
+}
+
+func newTiffReader(data []byte) (*tiffReader, bool) {
+	if len(data) < 8 {
+		return nil, false
+	}
+
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, false
+	}
+	if order.Uint16(data[2:]) != 42 {
+		return nil, false
+	}
+
+	return &tiffReader{data: data, order: order}, true
+}
+
+func (r *tiffReader) firstIFDOffset() uint32 {
+	return r.order.Uint32(r.data[4:])
+}
+
+func (r *tiffReader) uint16(b []byte) uint16 {
+	if len(b) < 2 {
+		return 0
+	}
+	return r.order.Uint16(b)
+}
+
+func (r *tiffReader) uint32(b []byte) uint32 {
+	if len(b) < 4 {
+		return 0
+	}
+	return r.order.Uint32(b)
+}
+
+// readIFD offset 위치의 IFD 항목을 태그별로 읽기 (범위를 벗어난 항목은 무시)
+func (r *tiffReader) readIFD(offset uint32) map[uint16]ifdEntry {
+	entries := make(map[uint16]ifdEntry)
+	if uint64(offset)+2 > uint64(len(r.data)) {
+		return entries
+	}
+
+	count := int(r.order.Uint16(r.data[offset:]))
+	for i := 0; i < count; i++ {
+		pos := int(offset) + 2 + i*12
+		if pos+12 > len(r.data) {
+			break
+		}
+
+		tag := r.order.Uint16(r.data[pos:])
+		typ := r.order.Uint16(r.data[pos+2:])
+		valueCount := r.order.Uint32(r.data[pos+4:])
+		size := uint64(tiffTypeSize(typ)) * uint64(valueCount)
+		if size == 0 {
+			continue
+		}
+
+		value := r.data[pos+8 : pos+12]
+		if size > 4 {
+			valueOffset := uint64(r.order.Uint32(value))
+			if valueOffset+size > uint64(len(r.data)) {
+				continue
+			}
+			value = r.data[valueOffset : valueOffset+size]
+		}
+
+		entries[tag] = ifdEntry{typ: typ, count: valueCount, value: value}
+	}
+
+	return entries
+}
+
+// gpsCoordinate 도/분/초 RATIONAL 3개를 십진 좌표로 변환 (negativeRef이면 음수)
+func (r *tiffReader) gpsCoordinate(entry ifdEntry, ref string, negativeRef string) (float64, bool) {
+	if entry.typ != 5 || entry.count != 3 || len(entry.value) < 24 {
+		return 0, false
+	}
+
+	var parts [3]float64
+	for i := range parts {
+		num := r.uint32(entry.value[i*8:])
+		den := r.uint32(entry.value[i*8+4:])
+		if den == 0 {
+			return 0, false
+		}
+		parts[i] = float64(num) / float64(den)
+	}
+
+	coordinate := parts[0] + parts[1]/60 + parts[2]/3600
+	if strings.EqualFold(ref, negativeRef) {
+		coordinate = -coordinate
+	}
+	return coordinate, true
+}
+
+// tiffTypeSize TIFF 값 형식별 바이트 크기
+func tiffTypeSize(typ uint16) int {
+	switch typ {
+	case 1, 2, 6, 7: // BYTE, ASCII, SBYTE, UNDEFINED
+		return 1
+	case 3, 8: // SHORT, SSHORT
+		return 2
+	case 4, 9, 11: // LONG, SLONG, FLOAT
+		return 4
+	case 5, 10, 12: // RATIONAL, SRATIONAL, DOUBLE
+		return 8
+	}
+	return 0
+}
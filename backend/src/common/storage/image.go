@@ -0,0 +1,179 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"path"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// ImageVariant 업로드 시 생성되는 이미지 크기 종류
+type ImageVariant string
+
+const (
+	ImageVariantOriginal  ImageVariant = "original"  // 원본 크기 (방향 보정 후 다시 인코딩)
+	ImageVariantMedium    ImageVariant = "medium"    // 긴 변 1280px (상세 화면)
+	ImageVariantThumbnail ImageVariant = "thumbnail" // 긴 변 320px (목록, 지도)
+)
+
+// ImageVariants 생성되는 모든 이미지 크기
+var ImageVariants = []ImageVariant{ImageVariantOriginal, ImageVariantMedium, ImageVariantThumbnail}
+
+// imageVariantMaxSide 크기별 긴 변 최대 길이 (0이면 원본 크기 유지)
+var imageVariantMaxSide = map[ImageVariant]int{
+	ImageVariantOriginal:  0,
+	ImageVariantMedium:    1280,
+	ImageVariantThumbnail: 320,
+}
+
+// ErrUnsupportedImage 디코딩할 수 없거나 지원하지 않는 이미지 형식
+var ErrUnsupportedImage = fmt.Errorf("%w: unsupported image format (jpeg, png, webp only)", ErrInvalidImage)
+
+// ProcessedImage 크기별로 다시 인코딩된 이미지
+type ProcessedImage struct {
+	Variant     ImageVariant
+	Data        []byte
+	ContentType string
+	Ext         string
+	Width       int
+	Height      int
+}
+
+// ProcessImage JPEG/PNG/WebP 이미지의 magic bytes와 픽셀 크기를 검증한 뒤 디코딩하고 EXIF 방향을 적용해 원본, 중간, 썸네일 크기로 인코딩
+// 다시 인코딩하므로 결과물에는 GPS 위치를 포함한 EXIF 메타데이터가 남지 않음 (공개되는 사본의 개인정보 보호)
+func ProcessImage(data []byte) ([]ProcessedImage, error) {
+	if _, err := ValidateImageHeader(data); err != nil {
+		return nil, err
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+	if format != "jpeg" && format != "png" && format != "webp" {
+		return nil, ErrUnsupportedImage
+	}
+
+	img = applyOrientation(img, ReadExif(data).Orientation)
+
+	// 투명도가 있는 이미지는 PNG, 나머지는 JPEG로 저장
+	usePNG := format == "png" || !isOpaque(img)
+
+	processed := make([]ProcessedImage, 0, len(ImageVariants))
+	for _, variant := range ImageVariants {
+		resized := resizeToFit(img, imageVariantMaxSide[variant])
+
+		var buf bytes.Buffer
+		contentType, ext := "image/jpeg", ".jpg"
+		if usePNG {
+			contentType, ext = "image/png", ".png"
+			err = png.Encode(&buf, resized)
+		} else {
+			quality := 85
+			if variant == ImageVariantOriginal {
+				quality = 92
+			}
+			err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: quality})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s image: %w", variant, err)
+		}
+
+		bounds := resized.Bounds()
+		processed = append(processed, ProcessedImage{
+			Variant:     variant,
+			Data:        buf.Bytes(),
+			ContentType: contentType,
+			Ext:         ext,
+			Width:       bounds.Dx(),
+			Height:      bounds.Dy(),
+		})
+	}
+
+	return processed, nil
+}
+
+// VariantKey 원본 키와 같은 폴더에 있는 다른 크기의 키 ({folder}/{id}/original.jpg -> {folder}/{id}/thumbnail.jpg)
+func VariantKey(key string, variant ImageVariant) string {
+	dir, file := path.Split(key)
+	return dir + string(variant) + path.Ext(file)
+}
+
+// resizeToFit 긴 변이 maxSide 이하가 되도록 비율을 유지하며 축소 (이미 작으면 그대로 반환)
+func resizeToFit(img image.Image, maxSide int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if maxSide <= 0 || (width <= maxSide && height <= maxSide) {
+		return img
+	}
+
+	newWidth, newHeight := maxSide, maxSide
+	if width >= height {
+		newHeight = max(1, height*maxSide/width)
+	} else {
+		newWidth = max(1, width*maxSide/height)
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, newWidth, newHeight))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// applyOrientation EXIF 방향(1-8)에 맞게 이미지를 회전/반전
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	src := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+	w, h := bounds.Dx(), bounds.Dy()
+
+	// 5-8은 가로/세로가 바뀜
+	dstW, dstH := w, h
+	if orientation >= 5 {
+		dstW, dstH = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dstW, dstH))
+
+	for y := 0; y < dstH; y++ {
+		for x := 0; x < dstW; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // 좌우 반전
+				sx, sy = w-1-x, y
+			case 3: // 180도 회전
+				sx, sy = w-1-x, h-1-y
+			case 4: // 상하 반전
+				sx, sy = x, h-1-y
+			case 5: // 좌상-우하 대각선 기준 반전
+				sx, sy = y, x
+			case 6: // 시계 방향 90도 회전
+				sx, sy = y, h-1-x
+			case 7: // 우상-좌하 대각선 기준 반전
+				sx, sy = w-1-y, h-1-x
+			case 8: // 반시계 방향 90도 회전
+				sx, sy = w-1-y, x
+			}
+			si := src.PixOffset(sx, sy)
+			di := dst.PixOffset(x, y)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+
+	return dst
+}
+
+// isOpaque 투명한 픽셀이 없는 이미지인지 확인
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return true
+}
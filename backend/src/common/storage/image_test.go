@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"image"
+	"image/color"
+	"strconv"
+	"testing"
+)
+
+// patternImage 픽셀마다 다른 색을 가진 width x height 이미지 (좌표로 색을 정해 위치 비교 가능)
+func patternImage(width, height int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 40), G: uint8(y * 40), B: 100, A: 255})
+		}
+	}
+	return img
+}
+
+// assertSameImage 두 이미지의 크기와 모든 픽셀이 같은지 확인
+func assertSameImage(t *testing.T, got image.Image, want image.Image) {
+	t.Helper()
+	if got.Bounds().Dx() != want.Bounds().Dx() || got.Bounds().Dy() != want.Bounds().Dy() {
+		t.Fatalf("size = %dx%d, want %dx%d", got.Bounds().Dx(), got.Bounds().Dy(), want.Bounds().Dx(), want.Bounds().Dy())
+	}
+	for y := 0; y < want.Bounds().Dy(); y++ {
+		for x := 0; x < want.Bounds().Dx(); x++ {
+			g := color.NRGBAModel.Convert(got.At(got.Bounds().Min.X+x, got.Bounds().Min.Y+y))
+			w := color.NRGBAModel.Convert(want.At(want.Bounds().Min.X+x, want.Bounds().Min.Y+y))
+			if g != w {
+				t.Fatalf("pixel (%d,%d) = %v, want %v", x, y, g, w)
+			}
+		}
+	}
+}
+
+func TestApplyOrientationRoundTrip(t *testing.T) {
+	// 방향을 되돌리는 방향 (6, 8은 서로 반대 회전, 나머지는 두 번 적용하면 원래대로)
+	tests := []struct {
+		orientation int
+		inverse     int
+		swapsSize   bool
+	}{
+		{orientation: 2, inverse: 2},
+		{orientation: 3, inverse: 3},
+		{orientation: 4, inverse: 4},
+		{orientation: 5, inverse: 5, swapsSize: true},
+		{orientation: 6, inverse: 8, swapsSize: true},
+		{orientation: 7, inverse: 7, swapsSize: true},
+		{orientation: 8, inverse: 6, swapsSize: true},
+	}
+
+	src := patternImage(3, 2)
+	for _, tt := range tests {
+		t.Run("orientation "+strconv.Itoa(tt.orientation), func(t *testing.T) {
+			rotated := applyOrientation(src, tt.orientation)
+
+			wantW, wantH := 3, 2
+			if tt.swapsSize {
+				wantW, wantH = 2, 3
+			}
+			if rotated.Bounds().Dx() != wantW || rotated.Bounds().Dy() != wantH {
+				t.Fatalf("applyOrientation(%d) size = %dx%d, want %dx%d", tt.orientation, rotated.Bounds().Dx(), rotated.Bounds().Dy(), wantW, wantH)
+			}
+
+			assertSameImage(t, applyOrientation(rotated, tt.inverse), src)
+		})
+	}
+}
+
+func TestApplyOrientationPixels(t *testing.T) {
+	// 3x2 원본 (a b c / d e f)의 방향별 결과
+	src := patternImage(3, 2)
+	at := func(x, y int) color.NRGBA { return src.NRGBAAt(x, y) }
+	a, b, c := at(0, 0), at(1, 0), at(2, 0)
+	d, e, f := at(0, 1), at(1, 1), at(2, 1)
+
+	tests := []struct {
+		orientation int
+		want        [][]color.NRGBA // 행 단위
+	}{
+		{orientation: 2, want: [][]color.NRGBA{{c, b, a}, {f, e, d}}},
+		{orientation: 3, want: [][]color.NRGBA{{f, e, d}, {c, b, a}}},
+		{orientation: 4, want: [][]color.NRGBA{{d, e, f}, {a, b, c}}},
+		{orientation: 5, want: [][]color.NRGBA{{a, d}, {b, e}, {c, f}}},
+		{orientation: 6, want: [][]color.NRGBA{{d, a}, {e, b}, {f, c}}},
+		{orientation: 7, want: [][]color.NRGBA{{f, c}, {e, b}, {d, a}}},
+		{orientation: 8, want: [][]color.NRGBA{{c, f}, {b, e}, {a, d}}},
+	}
+
+	for _, tt := range tests {
+		t.Run("orientation "+strconv.Itoa(tt.orientation), func(t *testing.T) {
+			want := image.NewNRGBA(image.Rect(0, 0, len(tt.want[0]), len(tt.want)))
+			for y, row := range tt.want {
+				for x, px := range row {
+					want.SetNRGBA(x, y, px)
+				}
+			}
+			assertSameImage(t, applyOrientation(src, tt.orientation), want)
+		})
+	}
+}
+
+func TestApplyOrientationNoop(t *testing.T) {
+	src := patternImage(3, 2)
+	for _, orientation := range []int{-1, 0, 1, 9} {
+		if got := applyOrientation(src, orientation); got != image.Image(src) {
+			t.Fatalf("applyOrientation(%d) should return the source image unchanged", orientation)
+		}
+	}
+}
+
+func TestApplyOrientationOffsetBounds(t *testing.T) {
+	// SubImage처럼 원점이 (0,0)이 아닌 이미지도 같은 결과
+	full := patternImage(5, 4)
+	sub := full.SubImage(image.Rect(1, 1, 4, 3))
+	copied := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			copied.Set(x, y, sub.At(x+1, y+1))
+		}
+	}
+
+	for orientation := 2; orientation <= 8; orientation++ {
+		assertSameImage(t, applyOrientation(sub, orientation), applyOrientation(copied, orientation))
+	}
+}
+
+func TestVariantKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		variant ImageVariant
+		want    string
+	}{
+		{key: "memos/abc/original.jpg", variant: ImageVariantThumbnail, want: "memos/abc/thumbnail.jpg"},
+		{key: "memos/abc/original.jpg", variant: ImageVariantMedium, want: "memos/abc/medium.jpg"},
+		{key: "memos/abc/original.jpg", variant: ImageVariantOriginal, want: "memos/abc/original.jpg"},
+		{key: "profiles/7/xyz/original.png", variant: ImageVariantThumbnail, want: "profiles/7/xyz/thumbnail.png"},
+		{key: "original.webp", variant: ImageVariantMedium, want: "medium.webp"},
+		{key: "memos/abc/original", variant: ImageVariantThumbnail, want: "memos/abc/thumbnail"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key+"/"+string(tt.variant), func(t *testing.T) {
+			if got := VariantKey(tt.key, tt.variant); got != tt.want {
+				t.Fatalf("VariantKey(%q, %q) = %q, want %q", tt.key, tt.variant, got, tt.want)
+			}
+		})
+	}
+}
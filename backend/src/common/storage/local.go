@@ -0,0 +1,316 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// LocalRoutePrefix 로컬 저장소 파일을 제공하는 Echo 라우트 경로
+const LocalRoutePrefix = "/uploads"
+
+// LocalStorage 로컬 디스크 저장소 (AWS 없이 로컬 개발/테스트용, Echo static 라우트로 제공)
+type LocalStorage struct {
+	root          string
+	baseURL       string
+	secret        []byte
+	maxObjectSize int64
+}
+
+type LocalConfig struct {
+	Root          string // 파일 저장 경로 (Config.UploadPath)
+	BaseURL       string // 서버 외부 주소 (예: http://localhost:7001)
+	Secret        string // presigned URL 서명 키
+	MaxObjectSize int64  // presigned PUT 업로드 최대 크기
+}
+
+// NewLocalStorage 로컬 디스크 저장소 초기화 (저장 경로가 없으면 생성)
+func NewLocalStorage(cfg LocalConfig) (*LocalStorage, error) {
+	if err := os.MkdirAll(cfg.Root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create upload path: %w", err)
+	}
+
+	fmt.Printf("✅ Local storage initialized: %s\n", cfg.Root)
+	return &LocalStorage{
+		root:          cfg.Root,
+		baseURL:       strings.TrimRight(cfg.BaseURL, "/"),
+		secret:        []byte(cfg.Secret),
+		maxObjectSize: cfg.MaxObjectSize,
+	}, nil
+}
+
+// RegisterRoutes 저장된 파일 제공(GET)과 presigned 업로드(PUT) 라우트 등록
+func (l *LocalStorage) RegisterRoutes(e *echo.Echo) {
+	e.Static(LocalRoutePrefix, l.root)
+	e.PUT(LocalRoutePrefix+"*", l.handlePresignedPut) // static 라우트와 같은 경로 패턴이어야 GET이 405로 가려지지 않음
+}
+
+// Put 파일 저장
+func (l *LocalStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// Get 파일 읽기 (Content-Type은 확장자로 결정)
+func (l *LocalStorage) Get(ctx context.Context, key string) ([]byte, string, error) {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, "", ErrObjectNotFound
+		}
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, localContentType(key), nil
+}
+
+// GetPrefix 파일 앞부분만 읽기
+func (l *LocalStorage) GetPrefix(ctx context.Context, key string, n int64) ([]byte, error) {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, n))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, nil
+}
+
+// Head 파일 크기와 Content-Type 조회 (Content-Type은 파일 앞부분으로 판별)
+func (l *LocalStorage) Head(ctx context.Context, key string) (*ObjectInfo, error) {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+	header := make([]byte, 512)
+	n, err := io.ReadFull(file, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return &ObjectInfo{
+		Size:        stat.Size(),
+		ContentType: http.DetectContentType(header[:n]),
+	}, nil
+}
+
+// List prefix 아래의 모든 파일 조회 (업로드 중인 임시 파일은 제외)
+func (l *LocalStorage) List(ctx context.Context, prefix string) ([]ObjectSummary, error) {
+	var objects []ObjectSummary
+	err := filepath.WalkDir(l.root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(l.root, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectSummary{
+			Key:          key,
+			Size:         info.Size(),
+			LastModified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	return objects, nil
+}
+
+// Delete 파일 삭제
+func (l *LocalStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
+
+// PresignGet 로컬 파일은 static 라우트로 공개되어 있으므로 공개 URL 반환
+func (l *LocalStorage) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	if _, err := l.filePath(key); err != nil {
+		return "", err
+	}
+	return l.URL(key), nil
+}
+
+// PresignPut 서명된 로컬 업로드 URL (RegisterRoutes의 PUT 라우트에서 검증)
+func (l *LocalStorage) PresignPut(ctx context.Context, key string, contentType string, expires time.Duration) (string, error) {
+	if _, err := l.filePath(key); err != nil {
+		return "", err
+	}
+
+	expiresAt := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expiresAt)
+	query.Set("signature", l.sign(http.MethodPut, key, contentType, expiresAt))
+	return l.URL(key) + "?" + query.Encode(), nil
+}
+
+// URL 로컬 파일 공개 URL
+func (l *LocalStorage) URL(key string) string {
+	return l.baseURL + LocalRoutePrefix + "/" + escapeKey(key)
+}
+
+// KeyFromURL 로컬 파일 URL에서 키 추출
+func (l *LocalStorage) KeyFromURL(fileURL string) string {
+	prefix := l.baseURL + LocalRoutePrefix + "/"
+	if !strings.HasPrefix(fileURL, prefix) {
+		return ""
+	}
+	key := strings.TrimPrefix(fileURL, prefix)
+	if i := strings.IndexAny(key, "?#"); i >= 0 {
+		key = key[:i]
+	}
+	unescaped, err := url.PathUnescape(key)
+	if err != nil {
+		return ""
+	}
+	return unescaped
+}
+
+// handlePresignedPut presigned URL로 업로드된 파일 저장 (서명, 만료 시간, Content-Type, 크기 검증)
+func (l *LocalStorage) handlePresignedPut(c echo.Context) error {
+	key := strings.TrimPrefix(c.Param("*"), "/")
+	expiresAt := c.QueryParam("expires")
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+
+	expires, err := strconv.ParseInt(expiresAt, 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "upload url expired"})
+	}
+	expected := l.sign(http.MethodPut, key, contentType, expiresAt)
+	if !hmac.Equal([]byte(expected), []byte(c.QueryParam("signature"))) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "invalid upload signature"})
+	}
+
+	filePath, err := l.filePath(key)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create directory"})
+	}
+
+	// 메모리에 모으지 않고 임시 파일로 바로 기록한 뒤 크기 확인 후 교체
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to create file"})
+	}
+	defer os.Remove(tmp.Name())
+
+	body := io.Reader(c.Request().Body)
+	if l.maxObjectSize > 0 {
+		body = io.LimitReader(body, l.maxObjectSize+1)
+	}
+	written, err := io.Copy(tmp, body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "failed to read upload body"})
+	}
+	if l.maxObjectSize > 0 && written > l.maxObjectSize {
+		return c.JSON(http.StatusRequestEntityTooLarge, map[string]string{"error": "file too large"})
+	}
+
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to write file"})
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to write file"})
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// sign presigned URL 서명 (method, key, Content-Type, 만료 시각)
+func (l *LocalStorage) sign(method string, key string, contentType string, expiresAt string) string {
+	mac := hmac.New(sha256.New, l.secret)
+	mac.Write([]byte(strings.Join([]string{method, key, contentType, expiresAt}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// filePath 키를 저장 경로 안의 파일 경로로 변환 (상위 경로 접근 차단)
+func (l *LocalStorage) filePath(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned == "/" || cleaned != "/"+key {
+		return "", fmt.Errorf("invalid object key: %q", key)
+	}
+	return filepath.Join(l.root, filepath.FromSlash(cleaned)), nil
+}
+
+// localContentType 확장자로 Content-Type 결정
+func localContentType(key string) string {
+	if contentType := mime.TypeByExtension(path.Ext(key)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
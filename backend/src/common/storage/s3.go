@@ -3,10 +3,10 @@ package storage
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
-	"path/filepath"
+	"net/url"
 	"strings"
 	"time"
 
@@ -14,13 +14,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/google/uuid"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
 type S3Client struct {
-	client     *s3.Client
-	bucketName string
-	region     string
+	client        *s3.Client
+	presigner     *s3.PresignClient
+	bucketName    string
+	region        string
+	endpoint      string
+	publicBaseURL string
 }
 
 type S3Config struct {
@@ -28,13 +31,12 @@ type S3Config struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	BucketName      string
-	Endpoint        string
+	Endpoint        string // MinIO 등 S3 호환 서비스 주소 (path-style)
+	PublicBaseURL   string // CDN/CloudFront 주소 (설정 시 공개 URL에 사용)
 }
 
-var S3 *S3Client
-
-// InitS3 S3 클라이언트 초기화
-func InitS3(cfg S3Config) error {
+// NewS3Storage S3 클라이언트 초기화
+func NewS3Storage(cfg S3Config) (*S3Client, error) {
 	ctx := context.Background()
 
 	// AWS 설정 로드
@@ -59,7 +61,7 @@ func InitS3(cfg S3Config) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to load AWS config: %w", err)
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
 	}
 
 	// S3 클라이언트 생성
@@ -71,111 +73,203 @@ func InitS3(cfg S3Config) error {
 		}
 	})
 
-	S3 = &S3Client{
-		client:     s3Client,
-		bucketName: cfg.BucketName,
-		region:     cfg.Region,
-	}
-
 	fmt.Println("✅ S3 client initialized successfully")
-	return nil
+	return &S3Client{
+		client:        s3Client,
+		presigner:     s3.NewPresignClient(s3Client),
+		bucketName:    cfg.BucketName,
+		region:        cfg.Region,
+		endpoint:      strings.TrimRight(cfg.Endpoint, "/"),
+		publicBaseURL: strings.TrimRight(cfg.PublicBaseURL, "/"),
+	}, nil
 }
 
-// UploadFile S3에 파일 업로드
-func (s *S3Client) UploadFile(ctx context.Context, file multipart.File, fileHeader *multipart.FileHeader, folder string) (string, error) {
-	// 파일 확장자 추출
-	ext := filepath.Ext(fileHeader.Filename)
-	if ext == "" {
-		ext = ".jpg" // 기본값
+// Put S3에 객체 저장
+func (s *S3Client) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucketName),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(data),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload to S3: %w", err)
 	}
+	return nil
+}
 
-	// 고유한 파일명 생성 (UUID + timestamp)
-	filename := fmt.Sprintf("%s_%d%s", uuid.New().String(), time.Now().Unix(), ext)
-
-	// S3 키 생성 (folder/filename)
-	key := fmt.Sprintf("%s/%s", folder, filename)
-
-	// Content-Type 결정
-	contentType := fileHeader.Header.Get("Content-Type")
-	if contentType == "" {
-		contentType = getContentType(ext)
+// Get S3 객체 읽기
+func (s *S3Client) Get(ctx context.Context, key string) ([]byte, string, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, "", ErrObjectNotFound
+		}
+		return nil, "", fmt.Errorf("failed to get from S3: %w", err)
 	}
+	defer output.Body.Close()
 
-	// 파일 읽기
-	fileBytes, err := io.ReadAll(file)
+	data, err := io.ReadAll(output.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read file: %w", err)
+		return nil, "", fmt.Errorf("failed to read S3 object: %w", err)
 	}
 
-	fmt.Printf("📤 S3 업로드 시작: bucket=%s, key=%s, size=%d bytes, contentType=%s\n",
-		s.bucketName, key, len(fileBytes), contentType)
+	return data, aws.ToString(output.ContentType), nil
+}
 
-	// S3에 업로드 (바이너리 데이터를 올바르게 처리)
-	_, err = s.client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket:        aws.String(s.bucketName),
-		Key:           aws.String(key),
-		Body:          bytes.NewReader(fileBytes),
-		ContentType:   aws.String(contentType),
-		ContentLength: aws.Int64(int64(len(fileBytes))),
+// GetPrefix Range 요청으로 S3 객체 앞부분만 읽기
+func (s *S3Client) GetPrefix(ctx context.Context, key string, n int64) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+		Range:  aws.String(fmt.Sprintf("bytes=0-%d", n-1)),
 	})
-
 	if err != nil {
-		fmt.Printf("❌ S3 업로드 실패: %v\n", err)
-		return "", fmt.Errorf("failed to upload to S3: %w", err)
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get from S3: %w", err)
 	}
+	defer output.Body.Close()
 
-	// URL 생성 (CloudFront 사용 시 CloudFront URL로 변경 가능)
-	url := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, s.region, key)
+	data, err := io.ReadAll(io.LimitReader(output.Body, n))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read S3 object: %w", err)
+	}
+	return data, nil
+}
 
-	fmt.Printf("✅ S3 업로드 성공: %s\n", url)
+// Head S3 HeadObject로 객체 크기와 Content-Type 조회
+func (s *S3Client) Head(ctx context.Context, key string) (*ObjectInfo, error) {
+	output, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to head S3 object: %w", err)
+	}
 
-	return url, nil
+	return &ObjectInfo{
+		Size:        aws.ToInt64(output.ContentLength),
+		ContentType: aws.ToString(output.ContentType),
+	}, nil
 }
 
-// DeleteFile S3에서 파일 삭제
-func (s *S3Client) DeleteFile(ctx context.Context, fileURL string) error {
-	// URL에서 key 추출
-	key := extractKeyFromURL(fileURL)
-	if key == "" {
-		return fmt.Errorf("invalid file URL: %s", fileURL)
+// List prefix 아래의 모든 S3 객체 조회 (ListObjectsV2 페이지를 모두 읽음)
+func (s *S3Client) List(ctx context.Context, prefix string) ([]ObjectSummary, error) {
+	var objects []ObjectSummary
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucketName),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list S3 objects: %w", err)
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, ObjectSummary{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
 	}
+	return objects, nil
+}
 
+// Delete S3 객체 삭제
+func (s *S3Client) Delete(ctx context.Context, key string) error {
 	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(s.bucketName),
 		Key:    aws.String(key),
 	})
-
 	if err != nil {
 		return fmt.Errorf("failed to delete from S3: %w", err)
 	}
-
 	return nil
 }
 
-// extractKeyFromURL URL에서 S3 키 추출
-func extractKeyFromURL(fileURL string) string {
-	// https://bucket-name.s3.region.amazonaws.com/folder/filename 형식에서 folder/filename 추출
-	parts := strings.Split(fileURL, ".amazonaws.com/")
-	if len(parts) != 2 {
-		return ""
+// PresignGet 만료 시간이 있는 S3 다운로드 URL
+func (s *S3Client) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	request, err := s.presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		return "", fmt.Errorf("failed to presign S3 get: %w", err)
 	}
-	return parts[1]
+	return request.URL, nil
 }
 
-// getContentType 파일 확장자로 Content-Type 결정
-func getContentType(ext string) string {
-	contentTypes := map[string]string{
-		".jpg":  "image/jpeg",
-		".jpeg": "image/jpeg",
-		".png":  "image/png",
-		".gif":  "image/gif",
-		".webp": "image/webp",
-		".svg":  "image/svg+xml",
+// PresignPut 만료 시간이 있는 S3 업로드 URL (Content-Type 포함 서명)
+func (s *S3Client) PresignPut(ctx context.Context, key string, contentType string, expires time.Duration) (string, error) {
+	request, err := s.presigner.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		return "", fmt.Errorf("failed to presign S3 put: %w", err)
+	}
+	return request.URL, nil
+}
+
+// URL 객체 공개 URL (CDN 주소 > path-style 커스텀 엔드포인트 > S3 virtual-hosted 주소 순으로 사용)
+func (s *S3Client) URL(key string) string {
+	return s.urlPrefixes()[0] + escapeKey(key)
+}
+
+// KeyFromURL 이 버킷의 URL(CDN, 커스텀 엔드포인트, S3 주소 형식 모두)에서 키 추출
+func (s *S3Client) KeyFromURL(fileURL string) string {
+	if i := strings.IndexAny(fileURL, "?#"); i >= 0 {
+		fileURL = fileURL[:i]
+	}
+
+	for _, prefix := range s.urlPrefixes() {
+		if !strings.HasPrefix(fileURL, prefix) {
+			continue
+		}
+		key, err := url.PathUnescape(strings.TrimPrefix(fileURL, prefix))
+		if err != nil || key == "" {
+			return ""
+		}
+		return key
 	}
+	return ""
+}
 
-	if contentType, ok := contentTypes[strings.ToLower(ext)]; ok {
-		return contentType
+// urlPrefixes 이 버킷 객체의 URL prefix 목록 (첫 번째가 공개 URL 생성에 사용됨)
+func (s *S3Client) urlPrefixes() []string {
+	prefixes := make([]string, 0, 5)
+	if s.publicBaseURL != "" {
+		prefixes = append(prefixes, s.publicBaseURL+"/")
 	}
+	if s.endpoint != "" {
+		prefixes = append(prefixes, s.endpoint+"/"+s.bucketName+"/")
+	}
+	return append(prefixes,
+		fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", s.bucketName, s.region),
+		fmt.Sprintf("https://%s.s3.amazonaws.com/", s.bucketName),
+		fmt.Sprintf("https://s3.%s.amazonaws.com/%s/", s.region, s.bucketName),
+	)
+}
 
-	return "application/octet-stream"
+// escapeKey URL 경로에 사용할 수 있도록 키의 각 경로 요소 인코딩
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
 }
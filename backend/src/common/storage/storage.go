@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ObjectStorage 파일 저장소 인터페이스 (S3, 로컬 디스크)
+type ObjectStorage interface {
+	// Put 객체 저장 (같은 키가 있으면 덮어씀)
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get 객체 읽기 (데이터와 Content-Type 반환)
+	Get(ctx context.Context, key string) ([]byte, string, error)
+	// GetPrefix 객체 앞부분 최대 n bytes 읽기 (전체를 받지 않고 형식 확인)
+	GetPrefix(ctx context.Context, key string, n int64) ([]byte, error)
+	// Head 객체 크기와 Content-Type 조회 (데이터는 읽지 않음, 없으면 ErrObjectNotFound)
+	Head(ctx context.Context, key string) (*ObjectInfo, error)
+	// List prefix 아래의 모든 객체 조회
+	List(ctx context.Context, prefix string) ([]ObjectSummary, error)
+	// Delete 객체 삭제 (없는 객체는 에러로 처리하지 않음)
+	Delete(ctx context.Context, key string) error
+	// PresignGet 만료 시간이 있는 다운로드 URL 생성
+	PresignGet(ctx context.Context, key string, expires time.Duration) (string, error)
+	// PresignPut 만료 시간이 있는 업로드(PUT) URL 생성 (업로드 시 같은 Content-Type을 보내야 함)
+	PresignPut(ctx context.Context, key string, contentType string, expires time.Duration) (string, error)
+	// URL 객체의 공개 URL
+	URL(key string) string
+	// KeyFromURL 공개 URL에서 객체 키 추출 (이 저장소의 URL이 아니면 빈 값)
+	KeyFromURL(url string) string
+}
+
+// ObjectInfo 저장된 객체 정보
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+// ObjectSummary 목록 조회 결과의 객체 정보
+type ObjectSummary struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// Default 서버 시작 시 설정에 따라 초기화되는 저장소 (각 feature의 index.go에서 UseCase에 주입)
+var Default ObjectStorage
+
+// ErrNotConfigured 저장소가 초기화되지 않은 경우
+var ErrNotConfigured = errors.New("object storage is not configured")
+
+// ErrObjectNotFound 객체가 없는 경우
+var ErrObjectNotFound = errors.New("object not found")
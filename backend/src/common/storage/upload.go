@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 이미지 종류별 저장 폴더
+const (
+	MemoImageFolder    = "image/daily"
+	ProfileImageFolder = "profile"
+)
+
+// UploadedImage 크기별로 업로드된 이미지 정보
+type UploadedImage struct {
+	Key    string                  // 원본 키 ({folder}/{id}/original.{ext}), VariantKey로 다른 크기의 키를 구할 수 있음
+	URLs   map[ImageVariant]string // 크기별 URL
+	Width  int                     // 방향 보정 후 원본 너비
+	Height int                     // 방향 보정 후 원본 높이
+	Exif   ExifData                // 업로드 전 원본에서 읽은 EXIF (저장된 이미지에는 남지 않음)
+}
+
+// UploadImage 이미지를 검증(크기 제한, magic bytes, 픽셀 수)하고 디코딩/방향 보정 후 원본, 중간, 썸네일 크기로 저장소에 업로드
+func UploadImage(ctx context.Context, store ObjectStorage, file io.Reader, folder string) (*UploadedImage, error) {
+	if store == nil {
+		return nil, ErrNotConfigured
+	}
+
+	fileBytes, err := ReadImageFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	processed, err := ProcessImage(fileBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	baseKey := NewImageKey(folder, processed[0].Ext)
+
+	uploaded := &UploadedImage{
+		Key:  baseKey,
+		URLs: make(map[ImageVariant]string, len(processed)),
+		Exif: ReadExif(fileBytes),
+	}
+	for _, variant := range processed {
+		key := VariantKey(baseKey, variant.Variant)
+
+		fmt.Printf("📤 이미지 업로드 시작: key=%s, size=%d bytes, contentType=%s\n",
+			key, len(variant.Data), variant.ContentType)
+
+		if err := store.Put(ctx, key, variant.Data, variant.ContentType); err != nil {
+			fmt.Printf("❌ 이미지 업로드 실패: %v\n", err)
+			return nil, fmt.Errorf("failed to upload image: %w", err)
+		}
+
+		uploaded.URLs[variant.Variant] = store.URL(key)
+		if variant.Variant == ImageVariantOriginal {
+			uploaded.Width, uploaded.Height = variant.Width, variant.Height
+		}
+	}
+
+	fmt.Printf("✅ 이미지 업로드 성공: %s\n", uploaded.URLs[ImageVariantOriginal])
+
+	return uploaded, nil
+}
+
+// NewImageKey 새 이미지의 원본 키 생성 (모든 크기가 같은 폴더에 저장되도록 이미지마다 고유 폴더 사용)
+func NewImageKey(folder string, ext string) string {
+	return fmt.Sprintf("%s/%s_%d/%s%s", folder, uuid.New().String(), time.Now().Unix(), ImageVariantOriginal, ext)
+}
+
+// UploadableImageExt presigned URL로 직접 업로드할 수 있는 이미지 Content-Type과 확장자
+var UploadableImageExt = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// DeleteImageObjects 이미지 객체 삭제 (크기별 이미지가 있으면 모든 크기, 없으면 원본 키만 삭제)
+func DeleteImageObjects(ctx context.Context, store ObjectStorage, key string, hasVariants bool) error {
+	if store == nil {
+		return ErrNotConfigured
+	}
+	if hasVariants {
+		return DeleteImage(ctx, store, key)
+	}
+	return store.Delete(ctx, key)
+}
+
+// DeleteImage UploadImage로 저장한 모든 크기의 이미지 삭제 (key는 원본 키)
+func DeleteImage(ctx context.Context, store ObjectStorage, key string) error {
+	if store == nil {
+		return ErrNotConfigured
+	}
+
+	for _, variant := range ImageVariants {
+		if err := store.Delete(ctx, VariantKey(key, variant)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
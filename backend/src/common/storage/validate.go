@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// 업로드 이미지 검증 에러 (모두 ErrInvalidImage를 감싸므로 errors.Is(err, ErrInvalidImage)로 한 번에 확인 가능)
+var (
+	ErrInvalidImage  = errors.New("invalid image")
+	ErrFileTooLarge  = fmt.Errorf("%w: file too large", ErrInvalidImage)
+	ErrImageTooLarge = fmt.Errorf("%w: image dimensions too large", ErrInvalidImage)
+	ErrActiveContent = fmt.Errorf("%w: svg, html and other active content are not allowed", ErrInvalidImage)
+)
+
+// UploadLimits 업로드 이미지 제한
+type UploadLimits struct {
+	MaxFileSize  int64 // 파일 크기 (bytes)
+	MaxPixels    int64 // 너비 x 높이 (디코딩 시 메모리 사용량 제한)
+	MaxDimension int   // 긴 변 (px)
+}
+
+// Limits 모든 이미지 업로드 경로에 적용되는 제한 (서버 시작 시 설정값으로 변경)
+var Limits = UploadLimits{
+	MaxFileSize:  10 << 20,
+	MaxPixels:    50_000_000,
+	MaxDimension: 16384,
+}
+
+// ImageSniffLen 형식/크기 확인을 위해 읽는 파일 앞부분 길이 (JPEG는 EXIF 뒤에 크기 정보가 있어 넉넉하게 읽음)
+const ImageSniffLen = 1 << 20
+
+// ImageInfo 파일 앞부분으로 확인한 이미지 정보 (크기 정보를 찾지 못하면 Width, Height는 0)
+type ImageInfo struct {
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// CheckFileSize 파일 크기 제한 확인
+func CheckFileSize(size int64) error {
+	if size <= 0 {
+		return fmt.Errorf("%w: empty file", ErrInvalidImage)
+	}
+	if Limits.MaxFileSize > 0 && size > Limits.MaxFileSize {
+		return fmt.Errorf("%w (max %d bytes)", ErrFileTooLarge, Limits.MaxFileSize)
+	}
+	return nil
+}
+
+// ReadImageFile 업로드 파일을 크기 제한까지만 읽기 (제한을 넘으면 끝까지 읽지 않고 거부)
+func ReadImageFile(file io.Reader) ([]byte, error) {
+	reader := file
+	if Limits.MaxFileSize > 0 {
+		reader = io.LimitReader(file, Limits.MaxFileSize+1)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if err := CheckFileSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// DetectImageType magic bytes로 이미지 형식 판별 (파일명, 요청 Content-Type은 사용하지 않음)
+// JPEG/PNG/WebP만 허용하고 SVG, HTML 등 스크립트를 담을 수 있는 형식은 ErrActiveContent로 거부
+func DetectImageType(header []byte) (string, error) {
+	switch {
+	case bytes.HasPrefix(header, []byte{0xFF, 0xD8, 0xFF}):
+		return "image/jpeg", nil
+	case bytes.HasPrefix(header, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png", nil
+	case len(header) >= 12 && bytes.Equal(header[0:4], []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WEBP")):
+		return "image/webp", nil
+	}
+
+	if isActiveContent(header) {
+		return "", ErrActiveContent
+	}
+	return "", ErrUnsupportedImage
+}
+
+// ValidateImageHeader 파일 앞부분으로 형식과 픽셀 크기 확인 (디코딩 전에 호출해 큰 이미지로 인한 메모리 사용 방지)
+func ValidateImageHeader(header []byte) (*ImageInfo, error) {
+	contentType, err := DetectImageType(header)
+	if err != nil {
+		return nil, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(header))
+	if err != nil {
+		// 앞부분만 읽은 경우 크기 정보가 그 뒤에 있을 수 있음 (이 경우 크기 확인은 생략)
+		if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+			return &ImageInfo{ContentType: contentType}, nil
+		}
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedImage, err)
+	}
+
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("%w: invalid dimensions", ErrUnsupportedImage)
+	}
+	if (Limits.MaxDimension > 0 && max(config.Width, config.Height) > Limits.MaxDimension) ||
+		(Limits.MaxPixels > 0 && int64(config.Width)*int64(config.Height) > Limits.MaxPixels) {
+		return nil, fmt.Errorf("%w (%dx%d, max %d px per side and %d pixels)", ErrImageTooLarge, config.Width, config.Height, Limits.MaxDimension, Limits.MaxPixels)
+	}
+
+	return &ImageInfo{
+		ContentType: contentType,
+		Width:       config.Width,
+		Height:      config.Height,
+	}, nil
+}
+
+// isActiveContent SVG, HTML, XML 등 브라우저가 스크립트를 실행할 수 있는 텍스트 형식인지 확인
+func isActiveContent(header []byte) bool {
+	text := bytes.TrimLeft(bytes.TrimPrefix(header, []byte("\xEF\xBB\xBF")), " \t\r\n")
+	if bytes.HasPrefix(text, []byte("<")) {
+		return true
+	}
+
+	sniffed := http.DetectContentType(header)
+	return strings.HasPrefix(sniffed, "text/html") || strings.HasPrefix(sniffed, "text/xml") || strings.Contains(sniffed, "svg")
+}
@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+// pngHeader 시그니처와 IHDR 청크만 있는 PNG (픽셀 데이터 없이 크기만 선언)
+func pngHeader(width, height uint32) []byte {
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:4], width)
+	binary.BigEndian.PutUint32(ihdr[4:8], height)
+	ihdr[8] = 8 // bit depth
+	ihdr[9] = 6 // RGBA
+
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&buf, binary.BigEndian, uint32(len(ihdr)))
+	chunk := append([]byte("IHDR"), ihdr...)
+	buf.Write(chunk)
+	binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	return buf.Bytes()
+}
+
+// encodePNG width x height 크기의 실제 PNG
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("png encode: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withLimits 테스트 동안 업로드 제한 변경
+func withLimits(t *testing.T, limits UploadLimits) {
+	t.Helper()
+	prev := Limits
+	Limits = limits
+	t.Cleanup(func() { Limits = prev })
+}
+
+func TestDetectImageType(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  []byte
+		want    string
+		wantErr error
+	}{
+		{name: "jpeg", header: []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10}, want: "image/jpeg"},
+		{name: "png", header: []byte("\x89PNG\r\n\x1a\n\x00\x00"), want: "image/png"},
+		{name: "webp", header: []byte("RIFF\x24\x00\x00\x00WEBPVP8 "), want: "image/webp"},
+		{name: "riff without webp", header: []byte("RIFF\x24\x00\x00\x00WAVEfmt "), wantErr: ErrUnsupportedImage},
+		{name: "truncated webp", header: []byte("RIFF\x24\x00"), wantErr: ErrUnsupportedImage},
+		{name: "gif", header: []byte("GIF89a\x01\x00\x01\x00"), wantErr: ErrUnsupportedImage},
+		{name: "svg", header: []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`), wantErr: ErrActiveContent},
+		{name: "html", header: []byte("<!DOCTYPE html><html><body></body></html>"), wantErr: ErrActiveContent},
+		{name: "empty", header: nil, wantErr: ErrUnsupportedImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectImageType(tt.header)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("DetectImageType() error = %v, want %v", err, tt.wantErr)
+				}
+				if !errors.Is(err, ErrInvalidImage) {
+					t.Fatalf("DetectImageType() error = %v, want it to wrap ErrInvalidImage", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetectImageType() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("DetectImageType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsActiveContent(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []byte
+		want   bool
+	}{
+		{name: "svg", header: []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`), want: true},
+		{name: "svg with xml declaration", header: []byte(`<?xml version="1.0"?><svg></svg>`), want: true},
+		{name: "svg with BOM and whitespace", header: []byte("\xEF\xBB\xBF \r\n\t<svg></svg>"), want: true},
+		{name: "html", header: []byte("<html><script>alert(1)</script></html>"), want: true},
+		{name: "html doctype", header: []byte("<!doctype html>"), want: true},
+		{name: "html without leading tag", header: []byte("\x00\x00<html>"), want: false},
+		{name: "plain text", header: []byte("hello world"), want: false},
+		{name: "jpeg", header: []byte{0xFF, 0xD8, 0xFF, 0xE0}, want: false},
+		{name: "png", header: []byte("\x89PNG\r\n\x1a\n"), want: false},
+		{name: "empty", header: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isActiveContent(tt.header); got != tt.want {
+				t.Fatalf("isActiveContent(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateImageHeader(t *testing.T) {
+	withLimits(t, UploadLimits{
+		MaxFileSize:  10 << 20,
+		MaxPixels:    1_000_000,
+		MaxDimension: 2000,
+	})
+
+	tests := []struct {
+		name       string
+		header     []byte
+		wantErr    error
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "small png", header: encodePNG(t, 4, 3), wantWidth: 4, wantHeight: 3},
+		{name: "at pixel limit", header: pngHeader(1000, 1000), wantWidth: 1000, wantHeight: 1000},
+		{name: "at dimension limit", header: pngHeader(2000, 10), wantWidth: 2000, wantHeight: 10},
+		{name: "over pixel limit", header: pngHeader(1001, 1000), wantErr: ErrImageTooLarge},
+		{name: "over dimension limit", header: pngHeader(2001, 1), wantErr: ErrImageTooLarge},
+		{name: "decompression bomb", header: pngHeader(100_000, 100_000), wantErr: ErrImageTooLarge},
+		{name: "max uint32 dimensions", header: pngHeader(0x7FFFFFFF, 0x7FFFFFFF), wantErr: ErrInvalidImage},
+		{name: "zero width", header: pngHeader(0, 10), wantErr: ErrUnsupportedImage},
+		{name: "truncated jpeg", header: []byte{0xFF, 0xD8, 0xFF}},
+		{name: "svg", header: []byte("<svg></svg>"), wantErr: ErrActiveContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := ValidateImageHeader(tt.header)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ValidateImageHeader() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateImageHeader() unexpected error = %v", err)
+			}
+			if info.Width != tt.wantWidth || info.Height != tt.wantHeight {
+				t.Fatalf("ValidateImageHeader() = %dx%d, want %dx%d", info.Width, info.Height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestValidateImageHeaderNoLimits(t *testing.T) {
+	withLimits(t, UploadLimits{})
+
+	info, err := ValidateImageHeader(pngHeader(100_000, 100_000))
+	if err != nil {
+		t.Fatalf("ValidateImageHeader() with no limits error = %v", err)
+	}
+	if info.Width != 100_000 || info.Height != 100_000 {
+		t.Fatalf("ValidateImageHeader() = %dx%d, want 100000x100000", info.Width, info.Height)
+	}
+}
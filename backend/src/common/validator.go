@@ -1 +1,157 @@
 package common
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// FieldError 필드 단위 검증 에러 (field는 요청의 json 필드 이름)
+type FieldError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+	Msg   string `json:"msg"`
+}
+
+// ValidationErrors 필드 단위 검증 에러 목록
+type ValidationErrors []FieldError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, fieldErr := range v {
+		msgs[i] = fieldErr.Field + ": " + fieldErr.Msg
+	}
+	return strings.Join(msgs, ", ")
+}
+
+// ResValidationError 검증 실패 응답 (multipart, JSON 요청 모두 같은 형식)
+type ResValidationError struct {
+	ErrType string       `json:"errType"`
+	Msg     string       `json:"msg"`
+	Fields  []FieldError `json:"fields"`
+}
+
+// NewValidationError 검증 실패 응답 생성
+func NewValidationError(errs ValidationErrors) *ResValidationError {
+	return &ResValidationError{
+		ErrType: string(ErrBadParameter),
+		Msg:     "invalid request fields",
+		Fields:  errs,
+	}
+}
+
+// ValidateStruct validate 태그로 구조체 필드 검증
+// 지원 규칙: required, min=N, max=N (숫자는 값, 문자열은 글자 수, 배열은 길이), oneof=a b c, url
+// nil 포인터는 required가 아니면 검증하지 않음
+func ValidateStruct(s interface{}) ValidationErrors {
+	value := reflect.Indirect(reflect.ValueOf(s))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs ValidationErrors
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		tag := field.Tag.Get("validate")
+		if tag == "" || !field.IsExported() {
+			continue
+		}
+
+		name := jsonFieldName(field)
+		fieldValue := value.Field(i)
+		for _, rule := range strings.Split(tag, ",") {
+			if fieldErr := validateRule(name, fieldValue, rule); fieldErr != nil {
+				errs = append(errs, *fieldErr)
+				break
+			}
+		}
+	}
+	return errs
+}
+
+// validateRule 규칙 하나 검증 (통과하면 nil)
+func validateRule(name string, value reflect.Value, rule string) *FieldError {
+	ruleName, param, _ := strings.Cut(rule, "=")
+
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			if ruleName == "required" {
+				return &FieldError{Field: name, Rule: ruleName, Msg: "is required"}
+			}
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch ruleName {
+	case "required":
+		if (value.Kind() == reflect.String && strings.TrimSpace(value.String()) == "") || value.IsZero() {
+			return &FieldError{Field: name, Rule: ruleName, Msg: "is required"}
+		}
+	case "min", "max":
+		limit, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			panic(fmt.Sprintf("invalid validate rule %q on %s", rule, name))
+		}
+		size, unit, ok := measure(value)
+		if !ok {
+			return nil
+		}
+		if ruleName == "min" && size < limit {
+			return &FieldError{Field: name, Rule: ruleName, Msg: fmt.Sprintf("must be at least %s%s", param, unit)}
+		}
+		if ruleName == "max" && size > limit {
+			return &FieldError{Field: name, Rule: ruleName, Msg: fmt.Sprintf("must be at most %s%s", param, unit)}
+		}
+	case "oneof":
+		options := strings.Fields(param)
+		actual := fmt.Sprint(value.Interface())
+		for _, option := range options {
+			if actual == option {
+				return nil
+			}
+		}
+		return &FieldError{Field: name, Rule: ruleName, Msg: "must be one of " + strings.Join(options, ", ")}
+	case "url":
+		if value.Kind() != reflect.String || value.String() == "" {
+			return nil
+		}
+		parsed, err := url.ParseRequestURI(value.String())
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return &FieldError{Field: name, Rule: ruleName, Msg: "must be an http(s) URL"}
+		}
+	default:
+		panic(fmt.Sprintf("unknown validate rule %q on %s", rule, name))
+	}
+	return nil
+}
+
+// measure min/max 비교 값 (숫자는 값, 문자열은 글자 수, 배열은 길이)
+func measure(value reflect.Value) (size float64, unit string, ok bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), "", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), "", true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), "", true
+	case reflect.String:
+		return float64(utf8.RuneCountInString(value.String())), " characters", true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(value.Len()), " items", true
+	}
+	return 0, "", false
+}
+
+// jsonFieldName 에러에 표시할 필드 이름 (json 태그 우선)
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
@@ -5,12 +5,16 @@ import (
 	"main/features/auth/usecase"
 	"time"
 
+	"main/common"
 	"main/common/db/mysql"
 
 	"github.com/labstack/echo/v4"
 )
 
 func NewAuthHandler(e *echo.Echo) {
-	NewSignInAuthHandler(e, usecase.NewSignInAuthUseCase(repository.NewSignInAuthRepository(mysql.GormMysqlDB), 30*time.Second))
-	NewSignUpAuthHandler(e, usecase.NewSignUpAuthUseCase(repository.NewSignUpAuthRepository(mysql.GormMysqlDB), 30*time.Second))
+	hasher := common.NewBcryptHasher()
+	NewSignInAuthHandler(e, usecase.NewSignInAuthUseCase(repository.NewSignInAuthRepository(mysql.GormMysqlDB), hasher, 30*time.Second))
+	NewSignUpAuthHandler(e, usecase.NewSignUpAuthUseCase(repository.NewSignUpAuthRepository(mysql.GormMysqlDB), hasher, 30*time.Second))
+	NewRefreshAuthHandler(e, usecase.NewRefreshAuthUseCase(repository.NewRefreshAuthRepository(mysql.GormMysqlDB), 30*time.Second))
+	NewLogoutAuthHandler(e, usecase.NewLogoutAuthUseCase(repository.NewLogoutAuthRepository(mysql.GormMysqlDB), 30*time.Second))
 }
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/auth/model/interface"
+	"main/features/auth/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type LogoutAuthHandler struct {
+	UseCase _interface.ILogoutAuthUseCase
+}
+
+func NewLogoutAuthHandler(c *echo.Echo, useCase _interface.ILogoutAuthUseCase) _interface.ILogoutAuthHandler {
+	handler := &LogoutAuthHandler{
+		UseCase: useCase,
+	}
+	c.POST("/v0.1/auth/logout", handler.Logout)
+	return handler
+}
+
+// 로그아웃 api
+// @Router /v0.1/auth/logout [post]
+// @Summary 로그아웃 api
+// @Description 현재 세션의 리프레시 토큰을 폐기합니다
+// @Accept json
+// @Param request body request.ReqLogout true "로그아웃 요청 데이터"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags auth
+func (h *LogoutAuthHandler) Logout(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req request.ReqLogout
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request", common.ErrFromClient)
+	}
+
+	if err := h.UseCase.Logout(ctx, req); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/auth/model/interface"
+	"main/features/auth/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RefreshAuthHandler struct {
+	UseCase _interface.IRefreshAuthUseCase
+}
+
+func NewRefreshAuthHandler(c *echo.Echo, useCase _interface.IRefreshAuthUseCase) _interface.IRefreshAuthHandler {
+	handler := &RefreshAuthHandler{
+		UseCase: useCase,
+	}
+	c.POST("/v0.1/auth/refresh", handler.Refresh)
+	return handler
+}
+
+// 토큰 재발급 api
+// @Router /v0.1/auth/refresh [post]
+// @Summary 토큰 재발급 api
+// @Description 리프레시 토큰으로 새 액세스/리프레시 토큰을 발급합니다 (사용한 리프레시 토큰은 폐기되며, 재사용 시 세션 전체가 폐기됩니다)
+// @Accept json
+// @Produce json
+// @Param request body request.ReqRefresh true "토큰 재발급 요청 데이터"
+// @Success 200 {object} response.ResAuth
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags auth
+func (h *RefreshAuthHandler) Refresh(c echo.Context) error {
+	ctx := c.Request().Context()
+	var req request.ReqRefresh
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request", common.ErrFromClient)
+	}
+
+	res, err := h.UseCase.Refresh(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
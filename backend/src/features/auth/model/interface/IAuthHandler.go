@@ -9,3 +9,11 @@ type ISignInAuthHandler interface {
 type ISignUpAuthHandler interface {
 	SignUp(c echo.Context) error
 }
+
+type IRefreshAuthHandler interface {
+	Refresh(c echo.Context) error
+}
+
+type ILogoutAuthHandler interface {
+	Logout(c echo.Context) error
+}
@@ -6,10 +6,21 @@ import (
 )
 
 type ISignInAuthRepository interface {
-	CheckPassword(ctx context.Context, id, password string) (*mysql.User, error)
+	GetByAccountID(ctx context.Context, accountID string) (*mysql.User, error)
+	UpdatePassword(ctx context.Context, userID uint, hashedPassword string) error
+	SaveRefreshToken(ctx context.Context, token *mysql.RefreshToken) error
 }
 
 type ISignUpAuthRepository interface {
 	CheckAccountIDDuplicate(ctx context.Context, accountID string) error
 	CreateUser(ctx context.Context, userDTO *mysql.User) (*mysql.User, error)
+	SaveRefreshToken(ctx context.Context, token *mysql.RefreshToken) error
+}
+
+type IRefreshAuthRepository interface {
+	RotateRefreshToken(ctx context.Context, tokenID string, userID uint, next *mysql.RefreshToken) (*mysql.User, error)
+}
+
+type ILogoutAuthRepository interface {
+	RevokeFamily(ctx context.Context, tokenID string, userID uint) error
 }
@@ -13,3 +13,11 @@ type ISignInAuthUseCase interface {
 type ISignUpAuthUseCase interface {
 	SignUp(ctx context.Context, req request.ReqSignUp) (*response.ResAuth, error)
 }
+
+type IRefreshAuthUseCase interface {
+	Refresh(ctx context.Context, req request.ReqRefresh) (*response.ResAuth, error)
+}
+
+type ILogoutAuthUseCase interface {
+	Logout(ctx context.Context, req request.ReqLogout) error
+}
@@ -0,0 +1,5 @@
+package request
+
+type ReqLogout struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
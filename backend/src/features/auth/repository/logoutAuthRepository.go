@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/auth/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type LogoutAuthRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewLogoutAuthRepository(gormDB *gorm.DB) _interface.ILogoutAuthRepository {
+	return &LogoutAuthRepository{
+		GormDB: gormDB,
+	}
+}
+
+// RevokeFamily 리프레시 토큰이 속한 세션(패밀리)의 모든 토큰 폐기
+func (r *LogoutAuthRepository) RevokeFamily(ctx context.Context, tokenID string, userID uint) error {
+	var token mysql.RefreshToken
+	result := r.GormDB.WithContext(ctx).
+		Where("token_id = ? AND user_id = ?", tokenID, userID).
+		First(&token)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return common.ErrorMsg(ctx, common.ErrBadToken, common.Trace(), "unknown refresh token", common.ErrFromClient)
+		}
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), result.Error.Error(), common.ErrFromMysqlDB)
+	}
+
+	err := r.GormDB.WithContext(ctx).
+		Model(&mysql.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", token.FamilyID).
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/auth/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RefreshAuthRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewRefreshAuthRepository(gormDB *gorm.DB) _interface.IRefreshAuthRepository {
+	return &RefreshAuthRepository{
+		GormDB: gormDB,
+	}
+}
+
+// RotateRefreshToken 리프레시 토큰을 사용 처리하고 같은 패밀리로 새 토큰 저장
+// 이미 사용되었거나 폐기된 토큰이 다시 들어오면 탈취로 간주하고 패밀리 전체를 폐기한다
+func (r *RefreshAuthRepository) RotateRefreshToken(ctx context.Context, tokenID string, userID uint, next *mysql.RefreshToken) (*mysql.User, error) {
+	var user mysql.User
+	reused := false
+
+	err := r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 현재 토큰 조회 (동시 rotation 방지를 위해 row lock)
+		var current mysql.RefreshToken
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_id = ? AND user_id = ?", tokenID, userID).
+			First(&current)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return common.ErrorMsg(ctx, common.ErrBadToken, common.Trace(), "unknown refresh token", common.ErrFromClient)
+			}
+			return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), result.Error.Error(), common.ErrFromMysqlDB)
+		}
+
+		now := time.Now()
+
+		// 2. 재사용 탐지 - 패밀리 전체 폐기 (에러를 반환하면 롤백되므로 플래그로 처리)
+		if current.UsedAt != nil || current.RevokedAt != nil {
+			reused = true
+			return tx.Model(&mysql.RefreshToken{}).
+				Where("family_id = ? AND revoked_at IS NULL", current.FamilyID).
+				Update("revoked_at", now).Error
+		}
+
+		if now.After(current.ExpiresAt) {
+			return common.ErrorMsg(ctx, common.ErrBadToken, common.Trace(), "refresh token expired", common.ErrFromClient)
+		}
+
+		// 3. 사용자 조회
+		if err := tx.Where("id = ?", userID).First(&user).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return common.ErrorMsg(ctx, common.ErrUserNotFound, common.Trace(), "user not found", common.ErrFromClient)
+			}
+			return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+
+		// 4. 새 토큰 저장 (같은 패밀리 유지)
+		next.FamilyID = current.FamilyID
+		next.UserID = current.UserID
+		if err := tx.Create(next).Error; err != nil {
+			return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+
+		// 5. 현재 토큰 사용 처리
+		return tx.Model(&current).Updates(map[string]interface{}{
+			"used_at":     now,
+			"replaced_by": next.TokenID,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if reused {
+		return nil, common.ErrorMsg(ctx, common.ErrBadToken, common.Trace(), "refresh token reuse detected, session revoked", common.ErrFromClient)
+	}
+
+	return &user, nil
+}
@@ -20,13 +20,12 @@ func NewSignInAuthRepository(gormDB *gorm.DB) _interface.ISignInAuthRepository {
 	}
 }
 
-// CheckPassword account_id와 password로 사용자 조회
-func (r *SignInAuthRepository) CheckPassword(ctx context.Context, accountID, password string) (*mysql.User, error) {
+// GetByAccountID account_id로 사용자 조회
+func (r *SignInAuthRepository) GetByAccountID(ctx context.Context, accountID string) (*mysql.User, error) {
 	var user mysql.User
 
-	// account_id와 password로 사용자 조회
 	result := r.GormDB.WithContext(ctx).
-		Where("account_id = ? AND password = ?", accountID, password).
+		Where("account_id = ?", accountID).
 		First(&user)
 
 	// 에러 체크
@@ -40,3 +39,16 @@ func (r *SignInAuthRepository) CheckPassword(ctx context.Context, accountID, pas
 	// 사용자 정보 반환
 	return &user, nil
 }
+
+// UpdatePassword 비밀번호 해시 갱신 (레거시 평문 비밀번호 마이그레이션용)
+func (r *SignInAuthRepository) UpdatePassword(ctx context.Context, userID uint, hashedPassword string) error {
+	return r.GormDB.WithContext(ctx).
+		Model(&mysql.User{}).
+		Where("id = ?", userID).
+		Update("password", hashedPassword).Error
+}
+
+// SaveRefreshToken 발급된 리프레시 토큰 저장
+func (r *SignInAuthRepository) SaveRefreshToken(ctx context.Context, token *mysql.RefreshToken) error {
+	return r.GormDB.WithContext(ctx).Create(token).Error
+}
@@ -60,7 +60,17 @@ func (r *SignUpAuthRepository) CreateUser(ctx context.Context, userDTO *mysql.Us
 			return err
 		}
 
-		// 3. 사용자의 default_room_id 업데이트
+		// 3. 방 소유자를 owner 멤버로 등록
+		member := &mysql.RoomMember{
+			RoomID: room.ID,
+			UserID: userDTO.ID,
+			Role:   mysql.RoomRoleOwner,
+		}
+		if err := tx.Create(member).Error; err != nil {
+			return err
+		}
+
+		// 4. 사용자의 default_room_id 업데이트
 		userDTO.DefaultRoomID = &room.ID
 		if err := tx.Save(userDTO).Error; err != nil {
 			return err
@@ -76,3 +86,8 @@ func (r *SignUpAuthRepository) CreateUser(ctx context.Context, userDTO *mysql.Us
 	// 생성된 사용자 정보 반환 (ID와 DefaultRoomID가 자동 할당됨)
 	return userDTO, nil
 }
+
+// SaveRefreshToken 발급된 리프레시 토큰 저장
+func (r *SignUpAuthRepository) SaveRefreshToken(ctx context.Context, token *mysql.RefreshToken) error {
+	return r.GormDB.WithContext(ctx).Create(token).Error
+}
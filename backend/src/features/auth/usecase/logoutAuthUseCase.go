@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"main/common"
+	_interface "main/features/auth/model/interface"
+	"main/features/auth/model/request"
+	"time"
+)
+
+type LogoutAuthUseCase struct {
+	Repository     _interface.ILogoutAuthRepository
+	ContextTimeout time.Duration
+}
+
+func NewLogoutAuthUseCase(repo _interface.ILogoutAuthRepository, timeout time.Duration) _interface.ILogoutAuthUseCase {
+	return &LogoutAuthUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// Logout 현재 세션의 리프레시 토큰 패밀리 폐기
+func (uc *LogoutAuthUseCase) Logout(ctx context.Context, req request.ReqLogout) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if req.RefreshToken == "" {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "refresh_token is required", common.ErrFromClient)
+	}
+
+	claims, err := common.ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	return uc.Repository.RevokeFamily(ctx, claims.Id, claims.UserID)
+}
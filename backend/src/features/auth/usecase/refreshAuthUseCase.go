@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/auth/model/interface"
+	"main/features/auth/model/request"
+	"main/features/auth/model/response"
+	"time"
+)
+
+type RefreshAuthUseCase struct {
+	Repository     _interface.IRefreshAuthRepository
+	ContextTimeout time.Duration
+}
+
+func NewRefreshAuthUseCase(repo _interface.IRefreshAuthRepository, timeout time.Duration) _interface.IRefreshAuthUseCase {
+	return &RefreshAuthUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// Refresh 리프레시 토큰으로 새 액세스/리프레시 토큰 발급 (rotation)
+func (uc *RefreshAuthUseCase) Refresh(ctx context.Context, req request.ReqRefresh) (*response.ResAuth, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if req.RefreshToken == "" {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "refresh_token is required", common.ErrFromClient)
+	}
+
+	// 리프레시 토큰 검증
+	claims, err := common.ParseRefreshToken(req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 새 토큰 생성
+	refreshTokenID := mysql.PKIDGenerate()
+	accessToken, accessTokenExpiredAt, refreshToken, refreshTokenExpiredAt, err := common.GenerateToken(claims.Email, claims.UserID, refreshTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 기존 토큰 사용 처리 및 새 토큰 저장 (재사용 탐지 포함)
+	user, err := uc.Repository.RotateRefreshToken(ctx, claims.Id, claims.UserID, createRefreshTokenDTO(claims.UserID, refreshTokenID, "", refreshTokenExpiredAt))
+	if err != nil {
+		return nil, err
+	}
+
+	// 응답 생성
+	res := &response.ResAuth{
+		AccessToken:           accessToken,
+		AccessTokenExpiredAt:  accessTokenExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiredAt: refreshTokenExpiredAt,
+		UserID:                user.ID,
+		AccountID:             user.AccountID,
+		Nickname:              user.Nickname,
+		DefaultRoomID:         user.DefaultRoomID,
+	}
+
+	return res, nil
+}
@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"main/common"
+	"main/common/db/mysql"
 	_interface "main/features/auth/model/interface"
 	"main/features/auth/model/request"
 	"main/features/auth/model/response"
@@ -11,12 +13,14 @@ import (
 
 type SignInAuthUseCase struct {
 	Repository     _interface.ISignInAuthRepository
+	Hasher         common.PasswordHasher
 	ContextTimeout time.Duration
 }
 
-func NewSignInAuthUseCase(repo _interface.ISignInAuthRepository, timeout time.Duration) _interface.ISignInAuthUseCase {
+func NewSignInAuthUseCase(repo _interface.ISignInAuthRepository, hasher common.PasswordHasher, timeout time.Duration) _interface.ISignInAuthUseCase {
 	return &SignInAuthUseCase{
 		Repository:     repo,
+		Hasher:         hasher,
 		ContextTimeout: timeout,
 	}
 }
@@ -26,13 +30,34 @@ func (uc *SignInAuthUseCase) SignIn(ctx context.Context, req request.ReqSignIn)
 	defer cancle()
 
 	// 사용자 인증
-	user, err := uc.Repository.CheckPassword(ctx, req.AccountID, req.Password)
+	user, err := uc.Repository.GetByAccountID(ctx, req.AccountID)
 	if err != nil {
 		return nil, err
 	}
 
-	// JWT 토큰 생성
-	accessToken, accessTokenExpiredAt, refreshToken, refreshTokenExpiredAt, err := common.GenerateToken(user.AccountID, user.ID)
+	match, needsRehash := uc.Hasher.Verify(user.Password, req.Password)
+	if !match {
+		return nil, fmt.Errorf("invalid account ID or password")
+	}
+
+	// 레거시 평문 비밀번호는 로그인 성공 시 해시로 교체 (실패해도 로그인은 진행)
+	if needsRehash {
+		if hashed, err := uc.Hasher.Hash(req.Password); err == nil {
+			if err := uc.Repository.UpdatePassword(ctx, user.ID, hashed); err != nil {
+				common.LogWarning(fmt.Sprintf("password rehash failed - userID: %d, err: %v", user.ID, err))
+			}
+		}
+	}
+
+	// JWT 토큰 생성 (새 로그인 세션이므로 새 토큰 패밀리 시작)
+	refreshTokenID := mysql.PKIDGenerate()
+	accessToken, accessTokenExpiredAt, refreshToken, refreshTokenExpiredAt, err := common.GenerateToken(user.AccountID, user.ID, refreshTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 리프레시 토큰 저장
+	err = uc.Repository.SaveRefreshToken(ctx, createRefreshTokenDTO(user.ID, refreshTokenID, mysql.PKIDGenerate(), refreshTokenExpiredAt))
 	if err != nil {
 		return nil, err
 	}
@@ -3,7 +3,9 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"main/common"
+	"main/common/db/mysql"
 	_interface "main/features/auth/model/interface"
 	"main/features/auth/model/request"
 	"main/features/auth/model/response"
@@ -12,12 +14,14 @@ import (
 
 type SignUpAuthUseCase struct {
 	Repository     _interface.ISignUpAuthRepository
+	Hasher         common.PasswordHasher
 	ContextTimeout time.Duration
 }
 
-func NewSignUpAuthUseCase(repo _interface.ISignUpAuthRepository, timeout time.Duration) _interface.ISignUpAuthUseCase {
+func NewSignUpAuthUseCase(repo _interface.ISignUpAuthRepository, hasher common.PasswordHasher, timeout time.Duration) _interface.ISignUpAuthUseCase {
 	return &SignUpAuthUseCase{
 		Repository:     repo,
+		Hasher:         hasher,
 		ContextTimeout: timeout,
 	}
 }
@@ -37,8 +41,14 @@ func (uc *SignUpAuthUseCase) SignUp(ctx context.Context, req request.ReqSignUp)
 		return nil, errors.New("invalid authentication code")
 	}
 
+	// 비밀번호 해시
+	hashedPassword, err := uc.Hasher.Hash(req.Password)
+	if err != nil {
+		return nil, fmt.Errorf("invalid password: %w", err)
+	}
+
 	// 사용자 DTO 생성
-	userDTO := createUserDTO(req)
+	userDTO := createUserDTO(req, hashedPassword)
 
 	// 디비 저장
 	user, err := uc.Repository.CreateUser(ctx, userDTO)
@@ -46,8 +56,15 @@ func (uc *SignUpAuthUseCase) SignUp(ctx context.Context, req request.ReqSignUp)
 		return nil, err
 	}
 
-	// JWT 토큰 생성
-	accessToken, accessTokenExpiredAt, refreshToken, refreshTokenExpiredAt, err := common.GenerateToken(user.AccountID, user.ID)
+	// JWT 토큰 생성 (새 로그인 세션이므로 새 토큰 패밀리 시작)
+	refreshTokenID := mysql.PKIDGenerate()
+	accessToken, accessTokenExpiredAt, refreshToken, refreshTokenExpiredAt, err := common.GenerateToken(user.AccountID, user.ID, refreshTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 리프레시 토큰 저장
+	err = uc.Repository.SaveRefreshToken(ctx, createRefreshTokenDTO(user.ID, refreshTokenID, mysql.PKIDGenerate(), refreshTokenExpiredAt))
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"main/common/db/mysql"
 	"main/features/auth/model/request"
+	"time"
 )
 
 func ValidateAuthCode(code string) bool {
@@ -12,10 +13,20 @@ func ValidateAuthCode(code string) bool {
 	return false
 }
 
-func createUserDTO(req request.ReqSignUp) *mysql.User {
+func createUserDTO(req request.ReqSignUp, hashedPassword string) *mysql.User {
 	return &mysql.User{
 		AccountID: req.AccountID,
-		Password:  req.Password,
+		Password:  hashedPassword,
 		Nickname:  req.NickName,
 	}
 }
+
+// createRefreshTokenDTO 발급한 리프레시 토큰 저장용 DTO 생성
+func createRefreshTokenDTO(userID uint, tokenID string, familyID string, expiredAt int64) *mysql.RefreshToken {
+	return &mysql.RefreshToken{
+		TokenID:   tokenID,
+		FamilyID:  familyID,
+		UserID:    userID,
+		ExpiresAt: time.Unix(expiredAt, 0),
+	}
+}
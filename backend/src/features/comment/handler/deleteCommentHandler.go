@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"main/common"
 	_interface "main/features/comment/model/interface"
 	"net/http"
 	"strconv"
@@ -12,18 +13,18 @@ type DeleteCommentHandler struct {
 	UseCase _interface.IDeleteCommentUseCase
 }
 
-func NewDeleteCommentHandler(c *echo.Echo, useCase _interface.IDeleteCommentUseCase) _interface.IDeleteCommentHandler {
+func NewDeleteCommentHandler(c *echo.Group, useCase _interface.IDeleteCommentUseCase) _interface.IDeleteCommentHandler {
 	handler := &DeleteCommentHandler{
 		UseCase: useCase,
 	}
-	c.DELETE("/v0.1/comments/:comment_id", handler.Delete)
+	c.DELETE("/comments/:comment_id", handler.Delete)
 	return handler
 }
 
 // Delete 댓글 삭제 API
 // @Router /v0.1/comments/{comment_id} [delete]
 // @Summary 댓글 삭제 API
-// @Description 댓글을 삭제합니다 (본인 댓글만 삭제 가능)
+// @Description 댓글을 삭제합니다 (본인 댓글만, 메모가 속한 방의 owner, editor일 때만 삭제 가능)
 // @Produce json
 // @Param comment_id path integer true "댓글 ID"
 // @Success 200 {object} map[string]interface{}
@@ -32,10 +33,8 @@ func NewDeleteCommentHandler(c *echo.Echo, useCase _interface.IDeleteCommentUseC
 // @Failure 500 {object} map[string]interface{}
 // @Tags comment
 func (h *DeleteCommentHandler) Delete(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
 	// Path parameter에서 comment_id 추출
 	commentIDStr := c.Param("comment_id")
@@ -46,10 +45,7 @@ func (h *DeleteCommentHandler) Delete(c echo.Context) error {
 
 	// 댓글 삭제
 	if err := h.UseCase.Execute(ctx, uint(commentID), userID); err != nil {
-		if err.Error() == "record not found" {
-			return c.JSON(http.StatusNotFound, map[string]string{"error": "comment not found or not authorized"})
-		}
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{"message": "comment deleted successfully"})
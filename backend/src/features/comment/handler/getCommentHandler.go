@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"main/common"
 	_interface "main/features/comment/model/interface"
 	"net/http"
 	"strconv"
@@ -12,26 +13,28 @@ type GetCommentHandler struct {
 	UseCase _interface.IGetCommentUseCase
 }
 
-func NewGetCommentHandler(c *echo.Echo, useCase _interface.IGetCommentUseCase) _interface.IGetCommentHandler {
+func NewGetCommentHandler(c *echo.Group, useCase _interface.IGetCommentUseCase) _interface.IGetCommentHandler {
 	handler := &GetCommentHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/memo/:memo_id/comments", handler.GetList)
+	c.GET("/memo/:memo_id/comments", handler.GetList)
 	return handler
 }
 
 // GetList 댓글 목록 조회 API
 // @Router /v0.1/memo/{memo_id}/comments [get]
 // @Summary 댓글 목록 조회 API
-// @Description 특정 메모의 댓글 목록을 조회합니다
+// @Description 특정 메모의 댓글 목록을 조회합니다 (메모가 속한 방의 멤버만 가능)
 // @Produce json
 // @Param memo_id path integer true "메모 ID"
 // @Success 200 {object} response.ResCommentList
 // @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags comment
 func (h *GetCommentHandler) GetList(c echo.Context) error {
-	ctx := c.Request().Context()
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
 	// Path parameter에서 memo_id 추출
 	memoIDStr := c.Param("memo_id")
@@ -41,9 +44,9 @@ func (h *GetCommentHandler) GetList(c echo.Context) error {
 	}
 
 	// 댓글 목록 조회
-	comments, err := h.UseCase.ExecuteList(ctx, uint(memoID))
+	comments, err := h.UseCase.ExecuteList(ctx, uint(memoID), userID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, comments)
@@ -8,7 +8,7 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func NewCommentHandler(c *echo.Echo) {
+func NewCommentHandler(c *echo.Group) {
 	db := mysql.GormMysqlDB
 
 	// Repository 초기화
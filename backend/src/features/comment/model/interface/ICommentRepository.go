@@ -7,16 +7,22 @@ import (
 
 type ICreateCommentRepository interface {
 	Create(ctx context.Context, comment *mysql.Comment) error
+	GetMemo(ctx context.Context, memoID uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
 	UpdateMemoRating(ctx context.Context, memoID uint) error
 }
 
 type IGetCommentRepository interface {
 	GetListByMemoID(ctx context.Context, memoID uint) ([]mysql.Comment, error)
 	GetByID(ctx context.Context, id uint) (*mysql.Comment, error)
+	GetMemo(ctx context.Context, memoID uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
 }
 
 type IDeleteCommentRepository interface {
 	Delete(ctx context.Context, id uint, userID uint) error
 	GetMemoIDByCommentID(ctx context.Context, commentID uint) (uint, error)
+	GetMemo(ctx context.Context, memoID uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
 	UpdateMemoRating(ctx context.Context, memoID uint) error
 }
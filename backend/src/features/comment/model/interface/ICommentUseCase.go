@@ -11,7 +11,7 @@ type ICreateCommentUseCase interface {
 }
 
 type IGetCommentUseCase interface {
-	ExecuteList(ctx context.Context, memoID uint) (*response.ResCommentList, error)
+	ExecuteList(ctx context.Context, memoID uint, userID uint) (*response.ResCommentList, error)
 }
 
 type IDeleteCommentUseCase interface {
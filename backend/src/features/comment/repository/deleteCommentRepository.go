@@ -47,11 +47,14 @@ func (r *DeleteCommentRepository) UpdateMemoRating(ctx context.Context, memoID u
 		return err
 	}
 
-	// 메모의 평점 업데이트
+	// 메모의 평점 업데이트 (댓글도 메모 조회 응답에 포함되므로 메모 버전도 증가)
 	err = r.GormDB.WithContext(ctx).
 		Model(&mysql.Memo{}).
 		Where("id = ?", memoID).
-		Update("rating", uint8(avgRating+0.5)). // 반올림
+		Updates(map[string]interface{}{
+			"rating":  uint8(avgRating + 0.5), // 반올림
+			"version": gorm.Expr("version + 1"),
+		}).
 		Error
 
 	return err
@@ -73,3 +76,32 @@ func (r *DeleteCommentRepository) Delete(ctx context.Context, id uint, userID ui
 
 	return nil
 }
+
+// GetMemo 댓글이 속한 메모 조회 (휴지통의 메모는 ErrRecordNotFound)
+func (r *DeleteCommentRepository) GetMemo(ctx context.Context, memoID uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Select("id", "room_id", "user_id").
+		Where("id = ?", memoID).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *DeleteCommentRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
@@ -48,3 +48,32 @@ func (r *GetCommentRepository) GetByID(ctx context.Context, id uint) (*mysql.Com
 
 	return &comment, nil
 }
+
+// GetMemo 댓글이 속한 메모 조회 (휴지통의 메모는 ErrRecordNotFound)
+func (r *GetCommentRepository) GetMemo(ctx context.Context, memoID uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Select("id", "room_id", "user_id").
+		Where("id = ?", memoID).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *GetCommentRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"main/common"
 	"main/common/db/mysql"
 	_interface "main/features/comment/model/interface"
 	"main/features/comment/model/request"
@@ -19,6 +20,15 @@ func NewCreateCommentUseCase(repo _interface.ICreateCommentRepository) _interfac
 }
 
 func (u *CreateCommentUseCase) Execute(ctx context.Context, memoID uint, userID uint, req *request.ReqCreateComment) (*response.ResComment, error) {
+	// 메모가 속한 방의 owner, editor만 댓글 작성 가능 (휴지통의 메모에는 댓글을 달 수 없음)
+	member, err := getMemoMember(ctx, u.CreateCommentRepository.GetMemo, u.CreateCommentRepository.GetRoomMember, memoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkWritePermission(ctx, member); err != nil {
+		return nil, err
+	}
+
 	comment := &mysql.Comment{
 		MemoID:  memoID,
 		UserID:  userID,
@@ -28,12 +38,12 @@ func (u *CreateCommentUseCase) Execute(ctx context.Context, memoID uint, userID
 
 	// 댓글 생성
 	if err := u.CreateCommentRepository.Create(ctx, comment); err != nil {
-		return nil, err
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
 	}
 
 	// 댓글 추가 후 메모의 평점 업데이트
 	if err := u.CreateCommentRepository.UpdateMemoRating(ctx, memoID); err != nil {
-		return nil, err
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
 	}
 
 	return &response.ResComment{
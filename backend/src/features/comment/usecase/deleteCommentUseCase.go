@@ -2,7 +2,11 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"main/common"
 	_interface "main/features/comment/model/interface"
+
+	"gorm.io/gorm"
 )
 
 type DeleteCommentUseCase struct {
@@ -19,18 +23,35 @@ func (u *DeleteCommentUseCase) Execute(ctx context.Context, commentID uint, user
 	// 삭제 전에 메모 ID 조회
 	memoID, err := u.DeleteCommentRepository.GetMemoIDByCommentID(ctx, commentID)
 	if err != nil {
+		return commentDBError(ctx, err)
+	}
+
+	// 메모가 속한 방의 owner, editor만 댓글 삭제 가능 (방을 나갔거나 viewer가 된 작성자는 삭제 불가)
+	member, err := getMemoMember(ctx, u.DeleteCommentRepository.GetMemo, u.DeleteCommentRepository.GetRoomMember, memoID, userID)
+	if err != nil {
+		return err
+	}
+	if err := checkWritePermission(ctx, member); err != nil {
 		return err
 	}
 
-	// 댓글 삭제
+	// 댓글 삭제 (본인 댓글만)
 	if err := u.DeleteCommentRepository.Delete(ctx, commentID, userID); err != nil {
-		return err
+		return commentDBError(ctx, err)
 	}
 
 	// 댓글 삭제 후 메모의 평점 업데이트
 	if err := u.DeleteCommentRepository.UpdateMemoRating(ctx, memoID); err != nil {
-		return err
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
 	}
 
 	return nil
 }
+
+// commentDBError 댓글 조회/삭제 에러 변환 (없거나 본인 댓글이 아니면 ErrNotFound)
+func commentDBError(ctx context.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "comment not found or not authorized", common.ErrFromClient)
+	}
+	return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+}
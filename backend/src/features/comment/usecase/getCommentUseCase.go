@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"main/common"
 	_interface "main/features/comment/model/interface"
 	"main/features/comment/model/response"
 )
@@ -16,10 +17,15 @@ func NewGetCommentUseCase(repo _interface.IGetCommentRepository) _interface.IGet
 	}
 }
 
-func (u *GetCommentUseCase) ExecuteList(ctx context.Context, memoID uint) (*response.ResCommentList, error) {
+func (u *GetCommentUseCase) ExecuteList(ctx context.Context, memoID uint, userID uint) (*response.ResCommentList, error) {
+	// 메모가 속한 방의 멤버만 조회 가능 (viewer 포함)
+	if _, err := getMemoMember(ctx, u.GetCommentRepository.GetMemo, u.GetCommentRepository.GetRoomMember, memoID, userID); err != nil {
+		return nil, err
+	}
+
 	comments, err := u.GetCommentRepository.GetListByMemoID(ctx, memoID)
 	if err != nil {
-		return nil, err
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
 	}
 
 	resComments := make([]response.ResComment, len(comments))
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"main/common"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+// getMemoMember 댓글을 조회/작성할 메모가 속한 방의 멤버 정보 조회
+// 메모가 없거나 휴지통에 있으면 ErrNotFound, 방 멤버가 아니면 ErrRoomUserNotFound
+func getMemoMember(
+	ctx context.Context,
+	getMemo func(context.Context, uint) (*mysql.Memo, error),
+	getRoomMember func(context.Context, uint, uint) (*mysql.RoomMember, error),
+	memoID uint,
+	userID uint,
+) (*mysql.RoomMember, error) {
+	memo, err := getMemo(ctx, memoID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "memo not found", common.ErrFromClient)
+		}
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	member, err := getRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrorMsg(ctx, common.ErrRoomUserNotFound, common.Trace(), "user does not belong to the room", common.ErrFromClient)
+		}
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return member, nil
+}
+
+// checkWritePermission 댓글 작성/삭제 권한 확인 (방의 owner, editor만 가능)
+func checkWritePermission(ctx context.Context, member *mysql.RoomMember) error {
+	if !member.Role.CanEdit() {
+		return common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "viewer cannot write comments", common.ErrFromClient)
+	}
+	return nil
+}
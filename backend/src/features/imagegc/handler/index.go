@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/storage"
+	_interface "main/features/imagegc/model/interface"
+	"main/features/imagegc/repository"
+	"main/features/imagegc/usecase"
+	uploadUseCase "main/features/upload/usecase"
+	"time"
+)
+
+// StartImageGCJob 참조되지 않는 이미지 정리 작업을 백그라운드에서 주기적으로 실행 (IMAGE_GC_ENABLED=false면 실행하지 않음)
+func StartImageGCJob() {
+	if !common.Env.ImageGCEnabled || storage.Default == nil {
+		return
+	}
+
+	timeout := 30 * time.Minute
+	gracePeriod := time.Duration(common.Env.ImageGCGraceHours) * time.Hour
+	if gracePeriod < uploadUseCase.PendingUploadTTL {
+		// 유예 시간이 짧으면 완료 처리 중인 직접 업로드의 크기별 이미지가 메모에 연결되기 전에 삭제될 수 있음
+		fmt.Printf("⚠️ IMAGE_GC_GRACE_HOURS=%d는 최소 유예 시간보다 짧아 %s 사용\n", common.Env.ImageGCGraceHours, uploadUseCase.PendingUploadTTL)
+		gracePeriod = uploadUseCase.PendingUploadTTL
+	}
+	interval := time.Duration(common.Env.ImageGCIntervalHours) * time.Hour
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	imageGCRepo := repository.NewImageGCRepository(mysql.GormMysqlDB)
+	imageGCUseCase := usecase.NewImageGCUseCase(imageGCRepo, storage.Default, gracePeriod, timeout)
+
+	fmt.Printf("🧹 이미지 정리 작업 시작: every %s, grace %s, dryRun=%t\n", interval, gracePeriod, common.Env.ImageGCDryRun)
+	go runImageGCJob(imageGCUseCase, interval, common.Env.ImageGCDryRun)
+}
+
+// runImageGCJob 서버 시작 직후 한 번 실행한 뒤 interval마다 반복
+func runImageGCJob(useCase _interface.IImageGCUseCase, interval time.Duration, dryRun bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := useCase.CollectOrphanedImages(context.Background(), dryRun)
+		if err != nil {
+			fmt.Printf("❌ 이미지 정리 실패: %v\n", err)
+		} else {
+			if report.DryRun {
+				for _, object := range report.Orphaned {
+					fmt.Printf("🔍 [dry-run] 삭제 대상: key=%s, size=%d bytes, lastModified=%s\n", object.Key, object.Size, object.LastModified.Format(time.RFC3339))
+				}
+			}
+			fmt.Printf("🧹 이미지 정리 완료 (dryRun=%t): scanned=%d, referenced=%d, tooRecent=%d, orphaned=%d (%d bytes), deleted=%d, failed=%d, expiredUploads=%d\n",
+				report.DryRun, report.Scanned, report.Referenced, report.TooRecent, len(report.Orphaned), report.OrphanedBytes, report.Deleted, report.Failed, report.ExpiredUploads)
+		}
+
+		<-ticker.C
+	}
+}
@@ -0,0 +1,14 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+)
+
+type IImageGCRepository interface {
+	GetMemoImages(ctx context.Context) ([]mysql.MemoImage, error)
+	GetMemoCovers(ctx context.Context) ([]mysql.Memo, error)
+	GetProfileImageURLs(ctx context.Context) ([]string, error)
+	GetPendingUploads(ctx context.Context) ([]mysql.Upload, error)
+	DeleteUploads(ctx context.Context, ids []uint) error
+}
@@ -0,0 +1,10 @@
+package _interface
+
+import (
+	"context"
+	"main/features/imagegc/model/response"
+)
+
+type IImageGCUseCase interface {
+	CollectOrphanedImages(ctx context.Context, dryRun bool) (*response.ResImageGCReport, error)
+}
@@ -0,0 +1,26 @@
+package response
+
+import "time"
+
+// ResImageGCReport 이미지 정리 결과 (dry-run이면 삭제하지 않고 삭제 대상만 보고)
+type ResImageGCReport struct {
+	DryRun         bool                `json:"dry_run"`
+	StartedAt      time.Time           `json:"started_at"`
+	FinishedAt     time.Time           `json:"finished_at"`
+	GraceCutoff    time.Time           `json:"grace_cutoff"`    // 이 시각 이후에 올라간 파일은 참조가 없어도 유지
+	Scanned        int                 `json:"scanned"`         // 조회한 저장소 객체 수
+	Referenced     int                 `json:"referenced"`      // DB에서 참조 중인 객체 수
+	TooRecent      int                 `json:"too_recent"`      // 참조가 없지만 유예 기간 안이라 유지한 객체 수
+	Orphaned       []ResOrphanedObject `json:"orphaned"`        // 삭제 대상 (dry-run이 아니면 삭제 시도한 객체)
+	OrphanedBytes  int64               `json:"orphaned_bytes"`  // 삭제 대상 총 크기
+	Deleted        int                 `json:"deleted"`         // 실제로 삭제한 객체 수
+	Failed         int                 `json:"failed"`          // 삭제에 실패한 객체 수
+	ExpiredUploads int                 `json:"expired_uploads"` // 기한이 지난 미완료 업로드 수 (dry-run이 아니면 삭제)
+}
+
+// ResOrphanedObject 참조되지 않는 저장소 객체
+type ResOrphanedObject struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"last_modified"`
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/imagegc/model/interface"
+
+	"gorm.io/gorm"
+)
+
+type ImageGCRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewImageGCRepository(gormDB *gorm.DB) _interface.IImageGCRepository {
+	return &ImageGCRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetMemoImages 모든 메모 이미지의 객체 키 조회 (휴지통의 메모 이미지도 참조로 취급)
+func (r *ImageGCRepository) GetMemoImages(ctx context.Context) ([]mysql.MemoImage, error) {
+	var images []mysql.MemoImage
+	result := r.GormDB.WithContext(ctx).
+		Select("id", "object_key", "has_variants").
+		Find(&images)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return images, nil
+}
+
+// GetMemoCovers 대표 이미지 키 또는 URL이 있는 메모 조회 (삭제된 메모 포함)
+func (r *ImageGCRepository) GetMemoCovers(ctx context.Context) ([]mysql.Memo, error) {
+	var memos []mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Unscoped().
+		Select("id", "image_key", "image_url").
+		Where("image_key <> '' OR image_url <> ''").
+		Find(&memos)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return memos, nil
+}
+
+// GetProfileImageURLs 프로필 이미지 URL 조회 (탈퇴한 사용자 포함)
+func (r *ImageGCRepository) GetProfileImageURLs(ctx context.Context) ([]string, error) {
+	var urls []string
+	result := r.GormDB.WithContext(ctx).
+		Unscoped().
+		Model(&mysql.User{}).
+		Where("profile_image_url IS NOT NULL AND profile_image_url <> ''").
+		Pluck("profile_image_url", &urls)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return urls, nil
+}
+
+// GetPendingUploads 완료 처리되지 않은 업로드 조회
+func (r *ImageGCRepository) GetPendingUploads(ctx context.Context) ([]mysql.Upload, error) {
+	var uploads []mysql.Upload
+	result := r.GormDB.WithContext(ctx).
+		Where("status = ?", mysql.UploadStatusPending).
+		Find(&uploads)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return uploads, nil
+}
+
+// DeleteUploads 기한이 지난 업로드 삭제
+func (r *ImageGCRepository) DeleteUploads(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.GormDB.WithContext(ctx).Where("id IN ?", ids).Delete(&mysql.Upload{}).Error
+}
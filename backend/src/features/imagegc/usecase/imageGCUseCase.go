@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"main/common"
+	"main/common/storage"
+	_interface "main/features/imagegc/model/interface"
+	"main/features/imagegc/model/response"
+	"strings"
+	"time"
+)
+
+// imageGCPrefixes 정리 대상 저장소 경로 (이 경로 밖의 객체는 건드리지 않음)
+var imageGCPrefixes = []string{
+	storage.MemoImageFolder + "/",
+	storage.ProfileImageFolder + "/",
+}
+
+type ImageGCUseCase struct {
+	Repository     _interface.IImageGCRepository
+	Storage        storage.ObjectStorage
+	GracePeriod    time.Duration
+	ContextTimeout time.Duration
+}
+
+func NewImageGCUseCase(repo _interface.IImageGCRepository, store storage.ObjectStorage, gracePeriod time.Duration, timeout time.Duration) _interface.IImageGCUseCase {
+	return &ImageGCUseCase{
+		Repository:     repo,
+		Storage:        store,
+		GracePeriod:    gracePeriod,
+		ContextTimeout: timeout,
+	}
+}
+
+// CollectOrphanedImages 메모/프로필 이미지 경로의 객체 중 DB에서 참조하지 않고 유예 기간이 지난 객체 삭제
+// 참조 목록을 먼저 읽은 뒤 객체를 조회하므로, 그 사이 새로 올라간 파일은 유예 기간으로 보호됨
+func (uc *ImageGCUseCase) CollectOrphanedImages(ctx context.Context, dryRun bool) (*response.ResImageGCReport, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if uc.Storage == nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), storage.ErrNotConfigured.Error(), common.ErrFromInternal)
+	}
+
+	startedAt := time.Now()
+	report := &response.ResImageGCReport{
+		DryRun:      dryRun,
+		StartedAt:   startedAt,
+		GraceCutoff: startedAt.Add(-uc.GracePeriod),
+		Orphaned:    []response.ResOrphanedObject{},
+	}
+
+	referenced, expiredUploadIDs, err := uc.referencedKeys(ctx, startedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, prefix := range imageGCPrefixes {
+		objects, err := uc.Storage.List(ctx, prefix)
+		if err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+		}
+
+		for _, object := range objects {
+			report.Scanned++
+			if referenced[object.Key] {
+				report.Referenced++
+				continue
+			}
+			if object.LastModified.After(report.GraceCutoff) {
+				report.TooRecent++
+				continue
+			}
+
+			report.Orphaned = append(report.Orphaned, response.ResOrphanedObject{
+				Key:          object.Key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			})
+			report.OrphanedBytes += object.Size
+			if dryRun {
+				continue
+			}
+
+			if err := uc.Storage.Delete(ctx, object.Key); err != nil {
+				fmt.Printf("⚠️ 이미지 정리 실패: key=%s, err=%v\n", object.Key, err)
+				report.Failed++
+				continue
+			}
+			report.Deleted++
+		}
+	}
+
+	// 기한이 지난 미완료 업로드 기록 삭제 (파일은 위에서 참조 없는 객체로 정리됨)
+	report.ExpiredUploads = len(expiredUploadIDs)
+	if !dryRun {
+		if err := uc.Repository.DeleteUploads(ctx, expiredUploadIDs); err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+// referencedKeys DB에서 참조 중인 객체 키 목록과 기한이 지난 미완료 업로드 ID 조회
+// 크기별 이미지가 있는 이미지는 중간 크기/썸네일 키도 참조로 취급
+func (uc *ImageGCUseCase) referencedKeys(ctx context.Context, now time.Time) (map[string]bool, []uint, error) {
+	referenced := make(map[string]bool)
+	addKey := func(key string, withVariants bool) {
+		if key == "" {
+			return
+		}
+		referenced[key] = true
+		if withVariants {
+			for _, variant := range storage.ImageVariants {
+				referenced[storage.VariantKey(key, variant)] = true
+			}
+		}
+	}
+	// 전체 URL로 저장된 기존 데이터는 이 저장소의 URL인 경우에만 키로 변환
+	addKeyOrURL := func(keyOrURL string, withVariants bool) {
+		if strings.HasPrefix(keyOrURL, "http://") || strings.HasPrefix(keyOrURL, "https://") {
+			keyOrURL = uc.Storage.KeyFromURL(keyOrURL)
+		}
+		addKey(keyOrURL, withVariants)
+	}
+
+	images, err := uc.Repository.GetMemoImages(ctx)
+	if err != nil {
+		return nil, nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+	for _, image := range images {
+		addKeyOrURL(image.ObjectKey, image.HasVariants)
+	}
+
+	memos, err := uc.Repository.GetMemoCovers(ctx)
+	if err != nil {
+		return nil, nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+	for _, memo := range memos {
+		addKeyOrURL(memo.ImageKey, false)
+		addKeyOrURL(memo.ImageURL, false)
+	}
+
+	// 프로필 이미지는 크기별 이미지 중 하나의 URL이 저장되므로 같은 폴더의 다른 크기도 참조로 취급
+	profileURLs, err := uc.Repository.GetProfileImageURLs(ctx)
+	if err != nil {
+		return nil, nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+	for _, profileURL := range profileURLs {
+		addKeyOrURL(profileURL, true)
+	}
+
+	// 업로드 중인 파일은 참조로 취급하고, 기한이 지난 업로드는 정리 대상으로 반환
+	uploads, err := uc.Repository.GetPendingUploads(ctx)
+	if err != nil {
+		return nil, nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+	var expiredUploadIDs []uint
+	for _, upload := range uploads {
+		if upload.ExpiresAt.After(now) {
+			addKey(upload.ObjectKey, false)
+			continue
+		}
+		expiredUploadIDs = append(expiredUploadIDs, upload.ID)
+	}
+
+	return referenced, expiredUploadIDs, nil
+}
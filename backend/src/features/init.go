@@ -3,10 +3,17 @@ package features
 import (
 	"net/http"
 
+	"main/common/storage"
+
 	authHandler "main/features/auth/handler"
 	commentHandler "main/features/comment/handler"
+	imageGCHandler "main/features/imagegc/handler"
 	memoHandler "main/features/memo/handler"
 	profileHandler "main/features/profile/handler"
+	roomHandler "main/features/room/handler"
+	tagHandler "main/features/tag/handler"
+	uploadHandler "main/features/upload/handler"
+	_middleware "main/middleware"
 
 	"github.com/labstack/echo/v4"
 )
@@ -17,10 +24,28 @@ func InitHandler(e *echo.Echo) error {
 		return c.NoContent(http.StatusOK)
 	})
 
+	// 로컬 저장소 사용 시 업로드 파일 제공 라우트 등록
+	if localStorage, ok := storage.Default.(*storage.LocalStorage); ok {
+		localStorage.RegisterRoutes(e)
+	}
+
 	authHandler.NewAuthHandler(e)
-	memoHandler.NewMemoHandlers(e)
-	commentHandler.NewCommentHandler(e)
-	profileHandler.NewProfileHandlers(e)
+
+	// 인증이 필요한 API 그룹 (JWT 검증 후 uID, email을 context에 저장)
+	// 그룹 미들웨어는 prefix 아래의 없는 경로에도 적용되므로 /v0.1 아래로 한정 (각 handler의 라우트는 /v0.1 제외한 경로)
+	authGroup := e.Group("/v0.1", _middleware.TokenChecker)
+	memoHandler.NewMemoHandlers(authGroup)
+	commentHandler.NewCommentHandler(authGroup)
+	profileHandler.NewProfileHandlers(authGroup)
+	roomHandler.NewRoomHandlers(authGroup)
+	tagHandler.NewTagHandlers(authGroup)
+	uploadHandler.NewUploadHandlers(authGroup)
+
+	// 참조되지 않는 이미지 정리 작업
+	imageGCHandler.StartImageGCJob()
+
+	// 보관 기간이 지난 휴지통 메모 영구 삭제 작업
+	memoHandler.StartTrashPurgeJob()
 
 	return nil
 }
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"main/common"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// isJSONRequest Content-Type이 application/json인지 확인 (아니면 multipart/form 데이터로 처리)
+func isJSONRequest(c echo.Context) bool {
+	mediaType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	return err == nil && mediaType == echo.MIMEApplicationJSON
+}
+
+// bindJSONBody JSON 본문을 req에 바인딩 (형식이 잘못된 필드는 필드 단위 에러로 반환)
+// 본문은 JSON 객체 하나여야 하며 뒤에 다른 값이나 문자가 이어지면 거부
+func bindJSONBody(c echo.Context, req interface{}) common.ValidationErrors {
+	dec := json.NewDecoder(c.Request().Body)
+	if err := dec.Decode(req); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return common.ValidationErrors{{Field: typeErr.Field, Rule: "type", Msg: fmt.Sprintf("must be a valid %s", typeErr.Type)}}
+		}
+		var timeErr *time.ParseError
+		if errors.As(err, &timeErr) {
+			return common.ValidationErrors{{Field: "visited_at", Rule: "type", Msg: "must be an RFC3339 time"}}
+		}
+		return invalidJSONBody()
+	}
+	if err := dec.Decode(&struct{}{}); err != io.EOF {
+		return invalidJSONBody()
+	}
+	return nil
+}
+
+// invalidJSONBody 본문 전체가 JSON 객체 하나가 아닐 때의 검증 에러
+func invalidJSONBody() common.ValidationErrors {
+	return common.ValidationErrors{{Field: "body", Rule: "json", Msg: "must be a valid JSON object"}}
+}
+
+// validationFailed 필드 단위 검증 에러 응답 (multipart, JSON 요청 모두 같은 형식)
+func validationFailed(c echo.Context, errs common.ValidationErrors) error {
+	return c.JSON(http.StatusBadRequest, common.NewValidationError(errs))
+}
+
+// formParser 폼 필드를 타입에 맞게 파싱하며 형식 에러를 필드 단위로 모음
+// 필드가 없거나 빈 값이면 nil (기존 값 유지)
+type formParser struct {
+	c    echo.Context
+	errs common.ValidationErrors
+}
+
+func (p *formParser) fail(name string, msg string) {
+	p.errs = append(p.errs, common.FieldError{Field: name, Rule: "type", Msg: msg})
+}
+
+func (p *formParser) String(name string) *string {
+	value := p.c.FormValue(name)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func (p *formParser) Uint(name string) *uint {
+	value := p.c.FormValue(name)
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		p.fail(name, "must be a valid unsigned integer")
+		return nil
+	}
+	result := uint(parsed)
+	return &result
+}
+
+func (p *formParser) Uint8(name string) *uint8 {
+	value := p.c.FormValue(name)
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		p.fail(name, "must be a valid integer between 0 and 255")
+		return nil
+	}
+	result := uint8(parsed)
+	return &result
+}
+
+func (p *formParser) Bool(name string) *bool {
+	value := p.c.FormValue(name)
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		p.fail(name, "must be true or false")
+		return nil
+	}
+	return &parsed
+}
+
+func (p *formParser) Float(name string) *float64 {
+	value := p.c.FormValue(name)
+	if value == "" {
+		return nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		p.fail(name, "must be a valid number")
+		return nil
+	}
+	return &parsed
+}
+
+// Time RFC3339 시간 파싱 (예: 2026-10-18T12:30:00+09:00)
+func (p *formParser) Time(name string) *time.Time {
+	value := p.c.FormValue(name)
+	if value == "" {
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		p.fail(name, "must be an RFC3339 time")
+		return nil
+	}
+	return &parsed
+}
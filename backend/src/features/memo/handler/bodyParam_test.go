@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestBindJSONBody(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantField string // 비어 있으면 에러 없음
+	}{
+		{name: "object", body: `{"title":"a"}`},
+		{name: "trailing whitespace", body: "{\"title\":\"a\"}\n\t "},
+		{name: "second object", body: `{"title":"a"}{"title":"b"}`, wantField: "body"},
+		{name: "trailing garbage", body: `{"title":"a"}x`, wantField: "body"},
+		{name: "trailing brace", body: `{"title":"a"}}`, wantField: "body"},
+		{name: "not json", body: `title=a`, wantField: "body"},
+		{name: "empty", body: ``, wantField: "body"},
+		{name: "wrong type", body: `{"title":1}`, wantField: "title"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var dst struct {
+				Title string `json:"title"`
+			}
+			errs := bindJSONBody(c, &dst)
+			if tt.wantField == "" {
+				if len(errs) != 0 {
+					t.Fatalf("bindJSONBody() = %+v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tt.wantField {
+				t.Fatalf("bindJSONBody() = %+v, want one error on %q", errs, tt.wantField)
+			}
+		})
+	}
+}
@@ -1,12 +1,10 @@
 package handler
 
 import (
-	"fmt"
 	"main/common"
 	_interface "main/features/memo/model/interface"
 	"main/features/memo/model/request"
 	"net/http"
-	"strconv"
 
 	"github.com/labstack/echo/v4"
 )
@@ -15,148 +13,129 @@ type CreateMemoHandler struct {
 	UseCase _interface.ICreateMemoUseCase
 }
 
-func NewCreateMemoHandler(c *echo.Echo, useCase _interface.ICreateMemoUseCase) _interface.ICreateMemoHandler {
+func NewCreateMemoHandler(c *echo.Group, useCase _interface.ICreateMemoUseCase) _interface.ICreateMemoHandler {
 	handler := &CreateMemoHandler{
 		UseCase: useCase,
 	}
-	c.POST("/v0.1/memo", handler.CreateMemo)
+	c.POST("/memo", handler.CreateMemo)
 	return handler
 }
 
 // CreateMemo 메모 생성 API
 // @Router /v0.1/memo [post]
 // @Summary 메모 생성 API
-// @Description 새로운 메모를 생성합니다 (이미지 파일 포함 가능)
+// @Description 새로운 메모를 생성합니다 (이미지 파일 최대 10개 포함 가능, 첫 번째 이미지가 대표 이미지)
+// @Description application/json 본문도 받습니다 (이미지 파일 대신 image_url 사용)
+// @Description 형식이 잘못되었거나 검증에 실패한 필드는 400 응답의 fields에 필드별로 반환합니다
 // @Accept multipart/form-data
+// @Accept json
 // @Produce json
+// @Param room_id formData integer false "방 ID (없으면 기본 방)"
 // @Param title formData string true "메모 제목"
 // @Param content formData string false "메모 내용"
-// @Param image formData file false "이미지 파일"
+// @Param image_url formData string false "외부 대표 이미지 URL"
+// @Param image formData file false "대표 이미지 파일"
+// @Param images[] formData file false "이미지 파일 (여러 개 가능, image 뒤에 순서대로 추가)"
+// @Param captions[] formData string false "이미지 설명 (images[]와 같은 순서)"
 // @Param rating formData integer false "평점 (0-5)"
 // @Param is_pinned formData boolean false "고정 여부"
 // @Param latitude formData number false "위도"
 // @Param longitude formData number false "경도"
 // @Param location_name formData string false "장소명"
 // @Param category formData string false "카테고리"
+// @Param visited_at formData string false "방문 일시 (RFC3339)"
+// @Param use_photo_metadata formData boolean false "비어 있는 위치/방문 일시를 사진 EXIF(GPS, 촬영 시각)로 채움"
+// @Param tags formData []string false "태그 (여러 번 전달하거나 쉼표로 구분)"
 // @Success 201 {object} response.ResMemo
-// @Failure 400 {object} map[string]interface{}
+// @Failure 400 {object} common.ResValidationError
 // @Failure 500 {object} map[string]interface{}
 // @Tags memo
 func (h *CreateMemoHandler) CreateMemo(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
-
-	// TODO: JWT 또는 사용자 설정에서 기본 RoomID 가져오기
-	// 현재는 기본값 1 사용
-	roomID := uint(1)
-
-	// Form 데이터 파싱
-	req := request.ReqCreateMemo{
-		RoomID:  roomID,
-		Title:   c.FormValue("title"),
-		Content: c.FormValue("content"),
-	}
-
-	// Rating 파싱
-	if ratingStr := c.FormValue("rating"); ratingStr != "" {
-		if rating, err := strconv.ParseUint(ratingStr, 10, 8); err == nil {
-			req.Rating = uint8(rating)
-		}
-	}
-
-	// IsPinned 파싱
-	if pinnedStr := c.FormValue("is_pinned"); pinnedStr != "" {
-		if pinned, err := strconv.ParseBool(pinnedStr); err == nil {
-			req.IsPinned = pinned
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	var req request.ReqCreateMemo
+	var errs common.ValidationErrors
+	jsonBody := isJSONRequest(c)
+	if jsonBody {
+		// JSON 본문 (이미지 파일은 전달할 수 없으므로 image_url 또는 presigned 업로드 사용)
+		errs = bindJSONBody(c, &req)
+	} else {
+		// Form 데이터 파싱 (형식이 잘못된 값은 필드 단위 에러로 모음)
+		form := &formParser{c: c}
+		req.Title = c.FormValue("title")
+		req.Content = c.FormValue("content")
+		req.ImageURL = c.FormValue("image_url")
+		req.RoomID = form.Uint("room_id") // 없으면 UseCase에서 사용자의 기본 방 사용
+		if rating := form.Uint8("rating"); rating != nil {
+			req.Rating = *rating
 		}
-	}
-
-	// Latitude 파싱
-	if latStr := c.FormValue("latitude"); latStr != "" {
-		if lat, err := strconv.ParseFloat(latStr, 64); err == nil {
-			req.Latitude = &lat
+		if pinned := form.Bool("is_pinned"); pinned != nil {
+			req.IsPinned = *pinned
 		}
-	}
-
-	// Longitude 파싱
-	if lngStr := c.FormValue("longitude"); lngStr != "" {
-		if lng, err := strconv.ParseFloat(lngStr, 64); err == nil {
-			req.Longitude = &lng
+		req.Latitude = form.Float("latitude")
+		req.Longitude = form.Float("longitude")
+		req.LocationName = form.String("location_name")
+		req.Category = form.String("category")
+		req.VisitedAt = form.Time("visited_at")
+		if useMetadata := form.Bool("use_photo_metadata"); useMetadata != nil {
+			req.UsePhotoMetadata = *useMetadata
 		}
-	}
-
-	// LocationName 파싱
-	if locName := c.FormValue("location_name"); locName != "" {
-		req.LocationName = &locName
-	}
-
-	// Category 파싱
-	if category := c.FormValue("category"); category != "" {
-		req.Category = &category
-	}
-
-	// IsWishlist 파싱
-	if wishlistStr := c.FormValue("is_wishlist"); wishlistStr != "" {
-		if wishlist, err := strconv.ParseBool(wishlistStr); err == nil {
-			req.IsWishlist = wishlist
+		if wishlist := form.Bool("is_wishlist"); wishlist != nil {
+			req.IsWishlist = *wishlist
 		}
-	}
-
-	// BusinessName 파싱
-	if businessName := c.FormValue("business_name"); businessName != "" {
-		req.BusinessName = &businessName
-	}
-
-	// BusinessPhone 파싱
-	if businessPhone := c.FormValue("business_phone"); businessPhone != "" {
-		req.BusinessPhone = &businessPhone
-	}
-
-	// BusinessAddress 파싱
-	if businessAddress := c.FormValue("business_address"); businessAddress != "" {
-		req.BusinessAddress = &businessAddress
-	}
-
-	// NaverPlaceURL 파싱
-	if naverPlaceURL := c.FormValue("naver_place_url"); naverPlaceURL != "" {
-		req.NaverPlaceURL = &naverPlaceURL
-	}
-
-	// 이미지 파일 검증 및 처리
-	fileHeader, err := c.FormFile("image")
-	if err == nil && fileHeader != nil {
-		// 파일 크기 검증
-		if fileHeader.Size > common.Env.MaxFileSize {
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": fmt.Sprintf("file size exceeds maximum allowed size (%d bytes)", common.Env.MaxFileSize),
-			})
+		req.BusinessName = form.String("business_name")
+		req.BusinessPhone = form.String("business_phone")
+		req.BusinessAddress = form.String("business_address")
+		req.NaverPlaceURL = form.String("naver_place_url")
+
+		// Tags 파싱 (여러 번 전달하거나 쉼표로 구분)
+		req.Tags = formTags(c)
+		errs = form.errs
+	}
+
+	// 형식 에러가 없으면 validate 태그 검증 (제목 필수 등)
+	if len(errs) == 0 {
+		errs = common.ValidateStruct(req)
+	}
+	if len(errs) > 0 {
+		return validationFailed(c, errs)
+	}
+
+	if !jsonBody {
+		// 이미지 파일 처리 (크기/형식 검증은 저장소 업로드 시 수행)
+		fileHeader, err := c.FormFile("image")
+		if err == nil && fileHeader != nil {
+			// 파일 열기
+			file, err := fileHeader.Open()
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{
+					"error": "failed to open image file",
+				})
+			}
+			defer file.Close()
+
+			// Request에 파일 정보 담기 (UseCase에서 S3 업로드 처리)
+			req.ImageFile = file
+			req.ImageHeader = fileHeader
 		}
 
-		// 파일 열기
-		file, err := fileHeader.Open()
+		// 여러 이미지 파일 처리 (images[], captions[]는 같은 순서)
+		images, err := openMemoImages(c, formImageHeaders(c))
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, map[string]string{
 				"error": "failed to open image file",
 			})
 		}
-		defer file.Close()
-
-		// Request에 파일 정보 담기 (UseCase에서 S3 업로드 처리)
-		req.ImageFile = file
-		req.ImageHeader = fileHeader
-	}
-
-	// 제목 필수 검증
-	if req.Title == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "title is required"})
+		defer closeMemoImages(images)
+		req.Images = images
 	}
 
 	memo, err := h.UseCase.CreateMemo(ctx, userID, req)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return err
 	}
 
+	setMemoETag(c, memo)
 	return c.JSON(http.StatusCreated, memo)
 }
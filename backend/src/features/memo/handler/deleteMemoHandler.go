@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"main/common"
 	_interface "main/features/memo/model/interface"
 	"net/http"
 	"strconv"
@@ -12,38 +13,47 @@ type DeleteMemoHandler struct {
 	UseCase _interface.IDeleteMemoUseCase
 }
 
-func NewDeleteMemoHandler(c *echo.Echo, useCase _interface.IDeleteMemoUseCase) _interface.IDeleteMemoHandler {
+func NewDeleteMemoHandler(c *echo.Group, useCase _interface.IDeleteMemoUseCase) _interface.IDeleteMemoHandler {
 	handler := &DeleteMemoHandler{
 		UseCase: useCase,
 	}
-	c.DELETE("/v0.1/memo/:id", handler.DeleteMemo)
+	c.DELETE("/memo/:id", handler.DeleteMemo)
 	return handler
 }
 
 // DeleteMemo 메모 삭제 API
 // @Router /v0.1/memo/{id} [delete]
 // @Summary 메모 삭제 API
-// @Description 메모를 삭제합니다
+// @Description 메모를 휴지통으로 옮깁니다 (작성자 또는 방 소유자만 가능, 댓글도 함께 숨김)
+// @Description 휴지통의 메모는 복원할 수 있으며 보관 기간이 지나면 영구 삭제됩니다
+// @Description If-Match에 메모 조회 시 받은 ETag가 필요하며, 그 사이 메모가 바뀌었으면 현재 메모와 함께 412를 반환합니다
 // @Param id path int true "메모 ID"
+// @Param If-Match header string true "메모 ETag (예: \"3\", *이면 버전을 확인하지 않음)"
 // @Success 204 "No Content"
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
+// @Failure 412 {object} response.ResMemoConflict
+// @Failure 428 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags memo
 func (h *DeleteMemoHandler) DeleteMemo(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
 	}
 
-	err = h.UseCase.DeleteMemo(ctx, uint(id), userID)
+	// 동시 수정 확인용 메모 버전 (GET /v0.1/memo/:id 응답의 ETag)
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	err = h.UseCase.DeleteMemo(ctx, uint(id), userID, version)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return memoWriteError(c, err)
 	}
 
 	return c.NoContent(http.StatusNoContent)
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"errors"
+	"main/common"
+	"main/features/memo/model/response"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 조건부 요청 헤더 (echo에 상수가 없음)
+const (
+	headerETag        = "ETag"
+	headerIfMatch     = "If-Match"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// memoETag 메모 버전으로 만든 ETag (예: "3")
+func memoETag(version uint) string {
+	return `"` + strconv.FormatUint(uint64(version), 10) + `"`
+}
+
+// setMemoETag 응답에 메모 ETag 설정 (다음 수정 요청의 If-Match로 사용)
+func setMemoETag(c echo.Context, memo *response.ResMemo) {
+	if memo != nil {
+		c.Response().Header().Set(headerETag, memoETag(memo.Version))
+	}
+}
+
+// parseETags If-Match/If-None-Match 헤더의 ETag 목록 (약한 ETag의 W/ 접두사는 제거, gzip 프록시가 강한 ETag를 약하게 바꾸는 경우 대비)
+func parseETags(header string) []string {
+	var etags []string
+	for _, etag := range strings.Split(header, ",") {
+		etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+		if etag != "" {
+			etags = append(etags, etag)
+		}
+	}
+	return etags
+}
+
+// etagMatches If-None-Match 헤더에 etag가 있는지 확인 ("*"는 모든 버전과 일치)
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range parseETags(header) {
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion If-Match 헤더의 메모 버전 ("*"이면 0, 버전을 확인하지 않음)
+// 동시 수정으로 덮어쓰지 않도록 수정/삭제 요청에는 If-Match가 필수 (없으면 428)
+func ifMatchVersion(c echo.Context) (uint, error) {
+	header := c.Request().Header.Get(headerIfMatch)
+	if header == "" {
+		return 0, common.ErrorMsg(c.Request().Context(), common.ErrPreconditionRequired, common.Trace(), "If-Match header is required (use the ETag from GET /v0.1/memo/:id)", common.ErrFromClient)
+	}
+
+	etags := parseETags(header)
+	if len(etags) == 1 && etags[0] == "*" {
+		return 0, nil
+	}
+	if len(etags) == 1 && len(etags[0]) > 2 && strings.HasPrefix(etags[0], `"`) && strings.HasSuffix(etags[0], `"`) {
+		version, err := strconv.ParseUint(strings.Trim(etags[0], `"`), 10, 32)
+		if err == nil && version > 0 {
+			return uint(version), nil
+		}
+	}
+	return 0, common.ErrorMsg(c.Request().Context(), common.ErrBadParameter, common.Trace(), "If-Match must be a single memo ETag or *", common.ErrFromClient)
+}
+
+// memoWriteError 버전 불일치 에러면 현재 메모와 ETag로 412 응답, 그 외 에러는 그대로 반환
+func memoWriteError(c echo.Context, err error) error {
+	var conflict *response.ResMemoConflict
+	if !errors.As(err, &conflict) {
+		return err
+	}
+	setMemoETag(c, conflict.Current)
+	return c.JSON(http.StatusPreconditionFailed, conflict)
+}
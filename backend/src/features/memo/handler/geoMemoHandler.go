@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"fmt"
+	"main/common"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GeoMemoHandler struct {
+	UseCase _interface.IGeoMemoUseCase
+}
+
+func NewGeoMemoHandler(c *echo.Group, useCase _interface.IGeoMemoUseCase) _interface.IGeoMemoHandler {
+	handler := &GeoMemoHandler{
+		UseCase: useCase,
+	}
+	c.GET("/memo/nearby", handler.GetNearbyMemo)
+	c.GET("/memo/bbox", handler.GetBBoxMemo)
+	c.GET("/memo/clusters", handler.GetMemoClusters)
+	return handler
+}
+
+// GetNearbyMemo 주변 메모 조회 API
+// @Router /v0.1/memo/nearby [get]
+// @Summary 주변 메모 조회 API
+// @Description 중심 좌표 반경 안의 메모를 가까운 순으로 조회합니다 (distance_m 포함)
+// @Produce json
+// @Param lat query number true "중심 위도"
+// @Param lng query number true "중심 경도"
+// @Param radius_m query number true "반경 (m, 최대 50000)"
+// @Param room_id query int false "Room ID filter"
+// @Param is_wishlist query bool false "Wishlist filter"
+// @Param category query string false "Category filter"
+// @Param limit query int false "최대 개수 (기본 100, 최대 500)"
+// @Success 200 {object} response.ResMemoList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *GeoMemoHandler) GetNearbyMemo(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	lat, err := strconv.ParseFloat(c.QueryParam("lat"), 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "lat is required (number)"})
+	}
+	lng, err := strconv.ParseFloat(c.QueryParam("lng"), 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "lng is required (number)"})
+	}
+	radiusM, err := strconv.ParseFloat(c.QueryParam("radius_m"), 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "radius_m is required (number)"})
+	}
+
+	req := request.ReqNearbyMemo{
+		Latitude:  lat,
+		Longitude: lng,
+		RadiusM:   radiusM,
+	}
+	if err := parseGeoFilters(c, &req.RoomID, &req.IsWishlist, &req.Category, &req.Limit); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	memoList, err := h.UseCase.GetNearbyMemo(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, memoList)
+}
+
+// GetBBoxMemo 지도 영역 메모 조회 API
+// @Router /v0.1/memo/bbox [get]
+// @Summary 지도 영역 메모 조회 API
+// @Description 남서(sw)~북동(ne) 영역 안의 메모를 영역 중심과 가까운 순으로 조회합니다
+// @Produce json
+// @Param sw query string true "남서쪽 좌표 (lat,lng)"
+// @Param ne query string true "북동쪽 좌표 (lat,lng)"
+// @Param room_id query int false "Room ID filter"
+// @Param is_wishlist query bool false "Wishlist filter"
+// @Param category query string false "Category filter"
+// @Param limit query int false "최대 개수 (기본 100, 최대 500)"
+// @Success 200 {object} response.ResMemoList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *GeoMemoHandler) GetBBoxMemo(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	swLat, swLng, err := parseLatLng(c.QueryParam("sw"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid sw format (expected: lat,lng)"})
+	}
+	neLat, neLng, err := parseLatLng(c.QueryParam("ne"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid ne format (expected: lat,lng)"})
+	}
+
+	req := request.ReqBBoxMemo{
+		SouthWestLat: swLat,
+		SouthWestLng: swLng,
+		NorthEastLat: neLat,
+		NorthEastLng: neLng,
+	}
+	if err := parseGeoFilters(c, &req.RoomID, &req.IsWishlist, &req.Category, &req.Limit); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	memoList, err := h.UseCase.GetBBoxMemo(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, memoList)
+}
+
+// GetMemoClusters 지도 마커 클러스터 조회 API
+// @Router /v0.1/memo/clusters [get]
+// @Summary 지도 마커 클러스터 조회 API
+// @Description 지도 영역의 메모를 줌 레벨에 맞게 묶어서 개수, 중심 좌표, 대표 카테고리, 평균 평점을 반환합니다 (줌 17 이상은 개별 메모)
+// @Produce json
+// @Param bbox query string true "영역 (sw_lat,sw_lng,ne_lat,ne_lng)"
+// @Param zoom query int true "지도 줌 레벨 (0-22)"
+// @Param room_id query int false "Room ID filter"
+// @Param is_wishlist query bool false "Wishlist filter"
+// @Param category query string false "Category filter"
+// @Success 200 {object} response.ResMemoClusterList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *GeoMemoHandler) GetMemoClusters(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	parts := strings.Split(c.QueryParam("bbox"), ",")
+	if len(parts) != 4 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid bbox format (expected: sw_lat,sw_lng,ne_lat,ne_lng)"})
+	}
+	swLat, swLng, err := parseLatLng(parts[0] + "," + parts[1])
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid bbox format (expected: sw_lat,sw_lng,ne_lat,ne_lng)"})
+	}
+	neLat, neLng, err := parseLatLng(parts[2] + "," + parts[3])
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid bbox format (expected: sw_lat,sw_lng,ne_lat,ne_lng)"})
+	}
+
+	zoom, err := strconv.Atoi(c.QueryParam("zoom"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "zoom is required (integer)"})
+	}
+
+	req := request.ReqMemoClusters{
+		SouthWestLat: swLat,
+		SouthWestLng: swLng,
+		NorthEastLat: neLat,
+		NorthEastLng: neLng,
+		Zoom:         zoom,
+	}
+	var limit int
+	if err := parseGeoFilters(c, &req.RoomID, &req.IsWishlist, &req.Category, &limit); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	clusterList, err := h.UseCase.GetMemoClusters(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, clusterList)
+}
+
+// parseLatLng "lat,lng" 형식의 좌표 파싱
+func parseLatLng(value string) (float64, float64, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid coordinate")
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return lat, lng, nil
+}
+
+// parseGeoFilters 지도 조회 공통 필터 파싱 (room_id, is_wishlist, category, limit)
+func parseGeoFilters(c echo.Context, roomID **uint, isWishlist **bool, category **string, limit *int) error {
+	if roomIDStr := c.QueryParam("room_id"); roomIDStr != "" {
+		parsedRoomID, err := strconv.ParseUint(roomIDStr, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid room_id format")
+		}
+		id := uint(parsedRoomID)
+		*roomID = &id
+	}
+
+	if isWishlistStr := c.QueryParam("is_wishlist"); isWishlistStr != "" {
+		parsedIsWishlist, err := strconv.ParseBool(isWishlistStr)
+		if err != nil {
+			return fmt.Errorf("invalid is_wishlist format (expected: true or false)")
+		}
+		*isWishlist = &parsedIsWishlist
+	}
+
+	if categoryStr := c.QueryParam("category"); categoryStr != "" {
+		*category = &categoryStr
+	}
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return fmt.Errorf("invalid limit format")
+		}
+		*limit = parsedLimit
+	}
+
+	return nil
+}
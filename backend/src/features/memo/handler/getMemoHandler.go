@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"main/common"
 	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
 	"net/http"
 	"strconv"
 
@@ -12,31 +14,33 @@ type GetMemoHandler struct {
 	UseCase _interface.IGetMemoUseCase
 }
 
-func NewGetMemoHandler(c *echo.Echo, useCase _interface.IGetMemoUseCase) _interface.IGetMemoHandler {
+func NewGetMemoHandler(c *echo.Group, useCase _interface.IGetMemoUseCase) _interface.IGetMemoHandler {
 	handler := &GetMemoHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/memo/:id", handler.GetMemo)
-	c.GET("/v0.1/memo", handler.GetMemoList)
+	c.GET("/memo/:id", handler.GetMemo)
+	c.GET("/memo", handler.GetMemoList)
 	return handler
 }
 
 // GetMemo 메모 조회 API
 // @Router /v0.1/memo/{id} [get]
 // @Summary 메모 조회 API
-// @Description 특정 메모를 조회합니다
+// @Description 특정 메모를 조회합니다 (메모가 속한 방의 멤버만 가능)
+// @Description ETag 헤더로 메모 버전을 반환하며, If-None-Match가 현재 ETag와 같으면 본문 없이 304를 반환합니다
 // @Produce json
 // @Param id path int true "메모 ID"
+// @Param If-None-Match header string false "이전에 받은 메모 ETag"
 // @Success 200 {object} response.ResMemo
+// @Header 200 {string} ETag "메모 버전 (수정/삭제 시 If-Match로 전달)"
+// @Success 304 "Not Modified"
 // @Failure 400 {object} map[string]interface{}
 // @Failure 404 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags memo
 func (h *GetMemoHandler) GetMemo(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
@@ -45,7 +49,14 @@ func (h *GetMemoHandler) GetMemo(c echo.Context) error {
 
 	memo, err := h.UseCase.GetMemo(ctx, uint(id), userID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": "memo not found"})
+		return err
+	}
+
+	// 캐시된 메모가 있어도 매번 ETag로 다시 확인하도록 함 (바뀌지 않았으면 304로 본문 전송 생략)
+	setMemoETag(c, memo)
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, no-cache")
+	if etagMatches(c.Request().Header.Get(headerIfNoneMatch), memoETag(memo.Version)) {
+		return c.NoContent(http.StatusNotModified)
 	}
 
 	return c.JSON(http.StatusOK, memo)
@@ -54,33 +65,44 @@ func (h *GetMemoHandler) GetMemo(c echo.Context) error {
 // GetMemoList 메모 목록 조회 API
 // @Router /v0.1/memo [get]
 // @Summary 메모 목록 조회 API
-// @Description 사용자의 모든 메모를 조회합니다 (is_wishlist, room_id 필터 지원)
+// @Description 사용자가 속한 방들의 메모를 커서 기반으로 조회합니다 (is_wishlist, room_id, category 필터 및 정렬 지원)
 // @Produce json
 // @Param is_wishlist query bool false "Wishlist filter"
 // @Param room_id query int false "Room ID filter"
+// @Param category query string false "Category filter"
+// @Param tags query []string false "Tag filter (쉼표로 구분)"
+// @Param tag_match query string false "태그 필터 방식 (any: 하나라도, all: 모두, 기본 any)"
+// @Param limit query int false "페이지 크기 (기본 20, 최대 100)"
+// @Param after query string false "이전 응답의 next_cursor (sort, pinned_first와 거리순 정렬의 lat/lng는 이전 요청과 같아야 함)"
+// @Param sort query string false "정렬 기준 (created_at, updated_at, rating, distance)"
+// @Param pinned_first query bool false "고정된 메모 우선 정렬 (기본 true)"
+// @Param lat query number false "거리순 정렬 기준 위도 (sort=distance 시 필수)"
+// @Param lng query number false "거리순 정렬 기준 경도 (sort=distance 시 필수)"
 // @Success 200 {object} response.ResMemoList
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags memo
 func (h *GetMemoHandler) GetMemoList(c echo.Context) error {
-	ctx := c.Request().Context()
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
+	req := request.ReqGetMemoList{
+		After:       c.QueryParam("after"),
+		Sort:        c.QueryParam("sort"),
+		PinnedFirst: true,
+	}
 
 	// is_wishlist 파싱 및 검증
-	var isWishlist *bool
 	isWishlistStr := c.QueryParam("is_wishlist")
 	if isWishlistStr != "" {
 		parsedIsWishlist, err := strconv.ParseBool(isWishlistStr)
 		if err != nil {
 			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid is_wishlist format (expected: true or false)"})
 		}
-		isWishlist = &parsedIsWishlist
+		req.IsWishlist = &parsedIsWishlist
 	}
 
 	// room_id 파싱 및 검증
-	var roomID *uint
 	roomIDStr := c.QueryParam("room_id")
 	if roomIDStr != "" {
 		parsedRoomID, err := strconv.ParseUint(roomIDStr, 10, 32)
@@ -88,13 +110,56 @@ func (h *GetMemoHandler) GetMemoList(c echo.Context) error {
 			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid room_id format"})
 		}
 		roomIDUint := uint(parsedRoomID)
-		roomID = &roomIDUint
+		req.RoomID = &roomIDUint
+	}
+
+	// category 필터
+	if category := c.QueryParam("category"); category != "" {
+		req.Category = &category
+	}
+
+	// 태그 필터 (tag_match: any / all)
+	req.Tags = queryTags(c)
+	req.TagMatch = c.QueryParam("tag_match")
+
+	// limit 파싱 및 검증 (범위 검증은 UseCase에서)
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid limit format"})
+		}
+		req.Limit = limit
+	}
+
+	// pinned_first 파싱 및 검증
+	if pinnedFirstStr := c.QueryParam("pinned_first"); pinnedFirstStr != "" {
+		pinnedFirst, err := strconv.ParseBool(pinnedFirstStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid pinned_first format (expected: true or false)"})
+		}
+		req.PinnedFirst = pinnedFirst
+	}
+
+	// lat, lng 파싱 및 검증 (거리순 정렬용)
+	if latStr := c.QueryParam("lat"); latStr != "" {
+		lat, err := strconv.ParseFloat(latStr, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid lat format"})
+		}
+		req.Latitude = &lat
+	}
+	if lngStr := c.QueryParam("lng"); lngStr != "" {
+		lng, err := strconv.ParseFloat(lngStr, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid lng format"})
+		}
+		req.Longitude = &lng
 	}
 
 	// 메모 목록 조회
-	memoList, err := h.UseCase.GetMemoList(ctx, userID, roomID, isWishlist)
+	memoList, err := h.UseCase.GetMemoList(ctx, userID, req)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, memoList)
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"main/features/memo/model/request"
+	"mime/multipart"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// formImageHeaders images[] (또는 images) 파트의 파일 목록, 없으면 nil
+func formImageHeaders(c echo.Context) []*multipart.FileHeader {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil
+	}
+	if headers := form.File["images[]"]; len(headers) > 0 {
+		return headers
+	}
+	return form.File["images"]
+}
+
+// openMemoImages 이미지 파일을 열고 captions[]를 같은 순서로 연결 (열린 파일은 closeMemoImages로 닫아야 함)
+func openMemoImages(c echo.Context, headers []*multipart.FileHeader) ([]request.ReqMemoImage, error) {
+	var captions []string
+	if form, err := c.MultipartForm(); err == nil {
+		captions = form.Value["captions[]"]
+		if len(captions) == 0 {
+			captions = form.Value["captions"]
+		}
+	}
+
+	images := make([]request.ReqMemoImage, 0, len(headers))
+	for i, header := range headers {
+		file, err := header.Open()
+		if err != nil {
+			closeMemoImages(images)
+			return nil, err
+		}
+
+		image := request.ReqMemoImage{
+			File:   file,
+			Header: header,
+		}
+		if i < len(captions) {
+			if caption := strings.TrimSpace(captions[i]); caption != "" {
+				image.Caption = &caption
+			}
+		}
+		images = append(images, image)
+	}
+
+	return images, nil
+}
+
+func closeMemoImages(images []request.ReqMemoImage) {
+	for _, image := range images {
+		image.File.Close()
+	}
+}
@@ -1,7 +1,12 @@
 package handler
 
 import (
+	"context"
+	"fmt"
+	"main/common"
 	"main/common/db/mysql"
+	"main/common/storage"
+	_interface "main/features/memo/model/interface"
 	"main/features/memo/repository"
 	"main/features/memo/usecase"
 	"time"
@@ -9,26 +14,102 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func NewMemoHandlers(e *echo.Echo) {
+func NewMemoHandlers(e *echo.Group) {
 	timeout := 30 * time.Second
 
 	// Create
 	createRepo := repository.NewCreateMemoRepository(mysql.GormMysqlDB)
-	createUseCase := usecase.NewCreateMemoUseCase(createRepo, timeout)
+	createUseCase := usecase.NewCreateMemoUseCase(createRepo, storage.Default, timeout)
 	NewCreateMemoHandler(e, createUseCase)
 
 	// Get
 	getRepo := repository.NewGetMemoRepository(mysql.GormMysqlDB)
-	getUseCase := usecase.NewGetMemoUseCase(getRepo, timeout)
+	getUseCase := usecase.NewGetMemoUseCase(getRepo, storage.Default, timeout)
 	NewGetMemoHandler(e, getUseCase)
 
 	// Update
 	updateRepo := repository.NewUpdateMemoRepository(mysql.GormMysqlDB)
-	updateUseCase := usecase.NewUpdateMemoUseCase(updateRepo, timeout)
+	updateUseCase := usecase.NewUpdateMemoUseCase(updateRepo, storage.Default, timeout)
 	NewUpdateMemoHandler(e, updateUseCase)
 
 	// Delete
 	deleteRepo := repository.NewDeleteMemoRepository(mysql.GormMysqlDB)
-	deleteUseCase := usecase.NewDeleteMemoUseCase(deleteRepo, timeout)
+	deleteUseCase := usecase.NewDeleteMemoUseCase(deleteRepo, storage.Default, timeout)
 	NewDeleteMemoHandler(e, deleteUseCase)
+
+	// Search
+	searchRepo := repository.NewSearchMemoRepository(mysql.GormMysqlDB)
+	searchUseCase := usecase.NewSearchMemoUseCase(searchRepo, storage.Default, timeout)
+	NewSearchMemoHandler(e, searchUseCase)
+
+	// Geo (nearby / bbox)
+	geoRepo := repository.NewGeoMemoRepository(mysql.GormMysqlDB)
+	geoUseCase := usecase.NewGeoMemoUseCase(geoRepo, storage.Default, timeout)
+	NewGeoMemoHandler(e, geoUseCase)
+
+	// Images (reorder / remove)
+	imageRepo := repository.NewMemoImageRepository(mysql.GormMysqlDB)
+	imageUseCase := usecase.NewMemoImageUseCase(imageRepo, storage.Default, timeout)
+	NewMemoImageHandler(e, imageUseCase)
+
+	// Revisions (list / diff / rollback)
+	revisionRepo := repository.NewMemoRevisionRepository(mysql.GormMysqlDB)
+	revisionUseCase := usecase.NewMemoRevisionUseCase(revisionRepo, storage.Default, timeout)
+	NewMemoRevisionHandler(e, revisionUseCase)
+
+	// Trash (list / restore / permanent delete)
+	trashRepo := repository.NewTrashMemoRepository(mysql.GormMysqlDB)
+	trashUseCase := usecase.NewTrashMemoUseCase(trashRepo, storage.Default, trashRetention(), timeout)
+	NewTrashMemoHandler(e, trashUseCase)
+}
+
+// StartTrashPurgeJob 보관 기간이 지난 휴지통 메모를 백그라운드에서 주기적으로 영구 삭제 (MEMO_TRASH_PURGE_ENABLED=false면 실행하지 않음)
+func StartTrashPurgeJob() {
+	if !common.Env.MemoTrashPurgeEnabled {
+		return
+	}
+
+	timeout := 30 * time.Minute
+	interval := time.Duration(common.Env.MemoTrashPurgeIntervalHours) * time.Hour
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	trashRepo := repository.NewTrashMemoRepository(mysql.GormMysqlDB)
+	retention := trashRetention()
+	trashUseCase := usecase.NewTrashMemoUseCase(trashRepo, storage.Default, retention, timeout)
+
+	fmt.Printf("🗑️ 휴지통 정리 작업 시작: every %s, retention %s\n", interval, retention)
+	go runTrashPurgeJob(trashUseCase, interval)
+}
+
+// runTrashPurgeJob 서버 시작 직후 한 번 실행한 뒤 interval마다 반복
+func runTrashPurgeJob(useCase _interface.ITrashMemoUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report, err := useCase.PurgeExpiredTrash(context.Background())
+		if err != nil {
+			fmt.Printf("❌ 휴지통 정리 실패: %v\n", err)
+		}
+		if report != nil && report.Purged > 0 {
+			fmt.Printf("🗑️ 휴지통 정리 완료: purged=%d, deletedImages=%d, failedImages=%d\n", report.Purged, report.DeletedImages, report.FailedImages)
+		}
+
+		<-ticker.C
+	}
+}
+
+// minTrashRetention 휴지통 최소 보관 기간 (0 이하로 설정되면 서버 시작 직후 휴지통의 모든 메모가 영구 삭제되지 않도록)
+const minTrashRetention = 24 * time.Hour
+
+// trashRetention 휴지통 보관 기간 (MEMO_TRASH_RETENTION_DAYS, 최소 1일)
+func trashRetention() time.Duration {
+	retention := time.Duration(common.Env.MemoTrashRetentionDays) * 24 * time.Hour
+	if retention < minTrashRetention {
+		fmt.Printf("⚠️ MEMO_TRASH_RETENTION_DAYS=%d는 너무 짧아 %s로 보관 (최소 1일)\n", common.Env.MemoTrashRetentionDays, minTrashRetention)
+		return minTrashRetention
+	}
+	return retention
 }
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MemoImageHandler struct {
+	UseCase _interface.IMemoImageUseCase
+}
+
+func NewMemoImageHandler(c *echo.Group, useCase _interface.IMemoImageUseCase) _interface.IMemoImageHandler {
+	handler := &MemoImageHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/memo/:id/images/order", handler.ReorderMemoImages)
+	c.DELETE("/memo/:id/images/:image_id", handler.DeleteMemoImage)
+	return handler
+}
+
+// ReorderMemoImages 메모 이미지 순서 변경 API
+// @Router /v0.1/memo/{id}/images/order [put]
+// @Summary 메모 이미지 순서 변경 API
+// @Description 메모의 모든 이미지 ID를 원하는 순서로 전달합니다 (첫 번째 이미지가 대표 이미지, 방의 owner, editor만 가능)
+// @Accept json
+// @Produce json
+// @Param id path int true "메모 ID"
+// @Param request body request.ReqReorderMemoImages true "이미지 ID 순서"
+// @Success 200 {object} response.ResMemo
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *MemoImageHandler) ReorderMemoImages(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	var req request.ReqReorderMemoImages
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid request body"})
+	}
+
+	memo, err := h.UseCase.ReorderMemoImages(ctx, uint(id), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, memo)
+}
+
+// DeleteMemoImage 메모 이미지 삭제 API
+// @Router /v0.1/memo/{id}/images/{image_id} [delete]
+// @Summary 메모 이미지 삭제 API
+// @Description 메모의 이미지 하나를 삭제합니다 (대표 이미지를 삭제하면 다음 이미지가 대표 이미지, 방의 owner, editor만 가능)
+// @Param id path int true "메모 ID"
+// @Param image_id path int true "이미지 ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *MemoImageHandler) DeleteMemoImage(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	imageID, err := strconv.ParseUint(c.Param("image_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid image id"})
+	}
+
+	if err := h.UseCase.DeleteMemoImage(ctx, uint(id), uint(imageID), userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
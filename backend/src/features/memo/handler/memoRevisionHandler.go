@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MemoRevisionHandler struct {
+	UseCase _interface.IMemoRevisionUseCase
+}
+
+func NewMemoRevisionHandler(c *echo.Group, useCase _interface.IMemoRevisionUseCase) _interface.IMemoRevisionHandler {
+	handler := &MemoRevisionHandler{
+		UseCase: useCase,
+	}
+	c.GET("/memo/:id/revisions", handler.GetRevisions)
+	c.GET("/memo/:id/revisions/:revisionId", handler.GetRevisionDiff)
+	c.POST("/memo/:id/revisions/:revisionId/rollback", handler.RollbackMemo)
+	return handler
+}
+
+// GetRevisions 메모 수정 이력 목록 조회 API
+// @Router /v0.1/memo/{id}/revisions [get]
+// @Summary 메모 수정 이력 목록 조회 API
+// @Description 메모의 수정 이력(수정한 사용자, 시각, 변경된 필드)을 최근 수정순으로 조회합니다 (메모가 속한 방의 멤버만 가능)
+// @Produce json
+// @Param id path int true "메모 ID"
+// @Param limit query int false "페이지 크기 (기본 20, 최대 100)"
+// @Param offset query int false "건너뛸 이력 수"
+// @Success 200 {object} response.ResMemoRevisionList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *MemoRevisionHandler) GetRevisions(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	var req request.ReqGetRevisionList
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid limit"})
+		}
+		req.Limit = limit
+	}
+
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid offset"})
+		}
+		req.Offset = offset
+	}
+
+	revisions, err := h.UseCase.GetRevisions(ctx, uint(id), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, revisions)
+}
+
+// GetRevisionDiff 메모 수정 이력 diff 조회 API
+// @Router /v0.1/memo/{id}/revisions/{revisionId} [get]
+// @Summary 메모 수정 이력 diff 조회 API
+// @Description 수정 이력의 필드 단위 변경 내용을 조회합니다 (메모가 속한 방의 멤버만 가능, 이미지는 포함하지 않음)
+// @Produce json
+// @Param id path int true "메모 ID"
+// @Param revisionId path int true "수정 이력 ID"
+// @Param compare query string false "비교 대상 (previous: 이 수정의 전후, current: 이 수정 직후와 현재 메모, 기본 previous)"
+// @Success 200 {object} response.ResMemoRevisionDiff
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *MemoRevisionHandler) GetRevisionDiff(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	revisionID, err := strconv.ParseUint(c.Param("revisionId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid revision id"})
+	}
+
+	diff, err := h.UseCase.GetRevisionDiff(ctx, uint(id), uint(revisionID), userID, c.QueryParam("compare"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, diff)
+}
+
+// RollbackMemo 메모 롤백 API
+// @Router /v0.1/memo/{id}/revisions/{revisionId}/rollback [post]
+// @Summary 메모 롤백 API
+// @Description 메모를 수정 이력의 상태로 되돌립니다 (방의 owner, editor만 가능, 롤백도 새 수정 이력으로 기록)
+// @Description 메모가 속한 방과 이미지는 되돌리지 않습니다
+// @Produce json
+// @Param id path int true "메모 ID"
+// @Param revisionId path int true "수정 이력 ID"
+// @Param state query string false "되돌릴 상태 (after: 이 수정 직후, before: 이 수정 직전, 기본 after)"
+// @Success 200 {object} response.ResMemo
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 412 {object} response.ResMemoConflict "롤백 중 다른 요청이 먼저 수정함"
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *MemoRevisionHandler) RollbackMemo(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	revisionID, err := strconv.ParseUint(c.Param("revisionId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid revision id"})
+	}
+
+	memo, err := h.UseCase.RollbackMemo(ctx, uint(id), uint(revisionID), userID, c.QueryParam("state"))
+	if err != nil {
+		return memoWriteError(c, err)
+	}
+
+	setMemoETag(c, memo)
+	return c.JSON(http.StatusOK, memo)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SearchMemoHandler struct {
+	UseCase _interface.ISearchMemoUseCase
+}
+
+func NewSearchMemoHandler(c *echo.Group, useCase _interface.ISearchMemoUseCase) _interface.ISearchMemoHandler {
+	handler := &SearchMemoHandler{
+		UseCase: useCase,
+	}
+	c.GET("/memo/search", handler.SearchMemo)
+	return handler
+}
+
+// SearchMemo 메모 검색 API
+// @Router /v0.1/memo/search [get]
+// @Summary 메모 검색 API
+// @Description 사용자가 속한 방들의 메모를 제목, 내용, 장소명, 가게명, 주소에서 검색합니다 (관련도 순, 강조된 발췌문 포함)
+// @Produce json
+// @Param q query string true "검색어 (2~100자)"
+// @Param room_id query int false "Room ID filter"
+// @Param is_wishlist query bool false "Wishlist filter"
+// @Param tags query []string false "Tag filter (쉼표로 구분)"
+// @Param tag_match query string false "태그 필터 방식 (any: 하나라도, all: 모두, 기본 any)"
+// @Param limit query int false "페이지 크기 (기본 20, 최대 100)"
+// @Param offset query int false "건너뛸 결과 수"
+// @Success 200 {object} response.ResMemoSearchList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *SearchMemoHandler) SearchMemo(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	req := request.ReqSearchMemo{
+		Query: c.QueryParam("q"),
+	}
+
+	// room_id 파싱 및 검증
+	if roomIDStr := c.QueryParam("room_id"); roomIDStr != "" {
+		parsedRoomID, err := strconv.ParseUint(roomIDStr, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid room_id format"})
+		}
+		roomID := uint(parsedRoomID)
+		req.RoomID = &roomID
+	}
+
+	// is_wishlist 파싱 및 검증
+	if isWishlistStr := c.QueryParam("is_wishlist"); isWishlistStr != "" {
+		isWishlist, err := strconv.ParseBool(isWishlistStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid is_wishlist format (expected: true or false)"})
+		}
+		req.IsWishlist = &isWishlist
+	}
+
+	// 태그 필터 (tag_match: any / all)
+	req.Tags = queryTags(c)
+	req.TagMatch = c.QueryParam("tag_match")
+
+	// limit, offset 파싱 및 검증 (범위 검증은 UseCase에서)
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid limit format"})
+		}
+		req.Limit = limit
+	}
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid offset format"})
+		}
+		req.Offset = offset
+	}
+
+	result, err := h.UseCase.SearchMemo(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// formTags tags 폼 필드 파싱 (여러 번 전달하거나 쉼표로 구분), 필드가 없으면 nil
+// 필드가 빈 값으로 전달되면 빈 배열을 반환 (수정 시 모든 태그 제거)
+func formTags(c echo.Context) []string {
+	params, err := c.FormParams()
+	if err != nil {
+		return nil
+	}
+	values, ok := params["tags"]
+	if !ok {
+		return nil
+	}
+	return splitTagValues(values)
+}
+
+// queryTags tags 쿼리 파라미터 파싱 (여러 번 전달하거나 쉼표로 구분)
+func queryTags(c echo.Context) []string {
+	values, ok := c.QueryParams()["tags"]
+	if !ok {
+		return nil
+	}
+	return splitTagValues(values)
+}
+
+func splitTagValues(values []string) []string {
+	tags := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
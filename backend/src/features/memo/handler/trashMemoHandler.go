@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TrashMemoHandler struct {
+	UseCase _interface.ITrashMemoUseCase
+}
+
+func NewTrashMemoHandler(c *echo.Group, useCase _interface.ITrashMemoUseCase) _interface.ITrashMemoHandler {
+	handler := &TrashMemoHandler{
+		UseCase: useCase,
+	}
+	c.GET("/memo/trash", handler.GetTrashList)
+	c.POST("/memo/:id/restore", handler.RestoreMemo)
+	c.DELETE("/memo/:id/permanent", handler.PurgeMemo)
+	return handler
+}
+
+// GetTrashList 휴지통 메모 목록 조회 API
+// @Router /v0.1/memo/trash [get]
+// @Summary 휴지통 메모 목록 조회 API
+// @Description 삭제한 메모 중 복원할 수 있는 메모(작성자이거나 방 소유자인 메모)를 최근 삭제순으로 조회합니다
+// @Description purge_at이 지나면 댓글, 이미지와 함께 영구 삭제됩니다
+// @Produce json
+// @Param room_id query int false "Room ID filter"
+// @Param limit query int false "페이지 크기 (기본 20, 최대 100)"
+// @Param offset query int false "건너뛸 메모 수"
+// @Success 200 {object} response.ResTrashMemoList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *TrashMemoHandler) GetTrashList(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	var req request.ReqGetTrashList
+
+	if roomIDStr := c.QueryParam("room_id"); roomIDStr != "" {
+		roomID, err := strconv.ParseUint(roomIDStr, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid room_id"})
+		}
+		parsed := uint(roomID)
+		req.RoomID = &parsed
+	}
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid limit"})
+		}
+		req.Limit = limit
+	}
+
+	if offsetStr := c.QueryParam("offset"); offsetStr != "" {
+		offset, err := strconv.Atoi(offsetStr)
+		if err != nil || offset < 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid offset"})
+		}
+		req.Offset = offset
+	}
+
+	memos, err := h.UseCase.GetTrashList(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, memos)
+}
+
+// RestoreMemo 휴지통 메모 복원 API
+// @Router /v0.1/memo/{id}/restore [post]
+// @Summary 휴지통 메모 복원 API
+// @Description 휴지통의 메모를 복원합니다 (작성자 또는 방 소유자만 가능, 메모와 함께 숨겨진 댓글도 복원)
+// @Produce json
+// @Param id path int true "메모 ID"
+// @Success 200 {object} response.ResMemo
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *TrashMemoHandler) RestoreMemo(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	memo, err := h.UseCase.RestoreMemo(ctx, uint(id), userID)
+	if err != nil {
+		return err
+	}
+
+	setMemoETag(c, memo)
+	return c.JSON(http.StatusOK, memo)
+}
+
+// PurgeMemo 메모 영구 삭제 API
+// @Router /v0.1/memo/{id}/permanent [delete]
+// @Summary 메모 영구 삭제 API
+// @Description 휴지통의 메모를 댓글, 이미지와 함께 영구 삭제합니다 (작성자 또는 방 소유자만 가능, 되돌릴 수 없음)
+// @Description 휴지통에 없는 메모는 404 (먼저 DELETE /v0.1/memo/{id}로 휴지통에 옮겨야 함)
+// @Param id path int true "메모 ID"
+// @Param If-Match header string true "메모 ETag (예: \"3\", *이면 버전을 확인하지 않음)"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 412 {object} response.ResMemoConflict
+// @Failure 428 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *TrashMemoHandler) PurgeMemo(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	// 동시 수정 확인용 메모 버전 (휴지통 목록 응답의 version)
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	if err := h.UseCase.PurgeMemo(ctx, uint(id), userID, version); err != nil {
+		return memoWriteError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
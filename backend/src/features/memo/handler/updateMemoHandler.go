@@ -1,10 +1,12 @@
 package handler
 
 import (
-	"fmt"
+	"bytes"
+	"encoding/json"
 	"main/common"
 	_interface "main/features/memo/model/interface"
 	"main/features/memo/model/request"
+	"mime"
 	"net/http"
 	"strconv"
 
@@ -15,113 +17,217 @@ type UpdateMemoHandler struct {
 	UseCase _interface.IUpdateMemoUseCase
 }
 
-func NewUpdateMemoHandler(c *echo.Echo, useCase _interface.IUpdateMemoUseCase) _interface.IUpdateMemoHandler {
+func NewUpdateMemoHandler(c *echo.Group, useCase _interface.IUpdateMemoUseCase) _interface.IUpdateMemoHandler {
 	handler := &UpdateMemoHandler{
 		UseCase: useCase,
 	}
-	c.PUT("/v0.1/memo/:id", handler.UpdateMemo)
+	c.PUT("/memo/:id", handler.UpdateMemo)
+	c.PATCH("/memo/:id", handler.PatchMemo)
 	return handler
 }
 
 // UpdateMemo 메모 수정 API
 // @Router /v0.1/memo/{id} [put]
 // @Summary 메모 수정 API
-// @Description 메모를 수정합니다 (이미지 파일 포함 가능)
+// @Description 메모를 수정합니다 (방의 owner, editor만 가능, 이미지 파일 포함 가능, 변경된 필드는 수정 이력으로 기록)
+// @Description application/json 본문도 받습니다 (이미지 파일 대신 image_url 사용)
+// @Description 형식이 잘못되었거나 검증에 실패한 필드는 400 응답의 fields에 필드별로 반환합니다
+// @Description If-Match에 메모 조회 시 받은 ETag가 필요하며, 그 사이 메모가 바뀌었으면 현재 메모와 함께 412를 반환합니다
 // @Accept multipart/form-data
+// @Accept json
 // @Produce json
 // @Param id path int true "메모 ID"
+// @Param If-Match header string true "메모 ETag (예: \"3\", *이면 버전을 확인하지 않음)"
+// @Param room_id formData integer false "이동할 방 ID"
 // @Param title formData string false "메모 제목"
 // @Param content formData string false "메모 내용"
-// @Param image formData file false "이미지 파일"
+// @Param image_url formData string false "외부 대표 이미지 URL"
+// @Param image formData file false "대표 이미지 교체 파일"
+// @Param images[] formData file false "추가할 이미지 파일 (여러 개 가능, 기존 이미지 뒤에 추가)"
+// @Param captions[] formData string false "이미지 설명 (images[]와 같은 순서)"
 // @Param rating formData integer false "평점 (0-5)"
 // @Param is_pinned formData boolean false "고정 여부"
 // @Param latitude formData number false "위도"
 // @Param longitude formData number false "경도"
 // @Param location_name formData string false "장소명"
 // @Param category formData string false "카테고리"
+// @Param visited_at formData string false "방문 일시 (RFC3339)"
+// @Param is_wishlist formData boolean false "가고 싶은 곳 여부"
+// @Param business_name formData string false "상호명"
+// @Param business_phone formData string false "전화번호"
+// @Param business_address formData string false "주소"
+// @Param naver_place_url formData string false "네이버 플레이스 URL"
+// @Param tags formData []string false "태그 (없으면 유지, 빈 값이면 모두 제거)"
 // @Success 200 {object} response.ResMemo
-// @Failure 400 {object} map[string]interface{}
+// @Header 200 {string} ETag "수정된 메모 버전"
+// @Failure 400 {object} common.ResValidationError
 // @Failure 404 {object} map[string]interface{}
+// @Failure 412 {object} response.ResMemoConflict
+// @Failure 428 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Tags memo
 func (h *UpdateMemoHandler) UpdateMemo(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
 	}
 
-	// Form 데이터 파싱
-	req := request.ReqUpdateMemo{
-		Title:   c.FormValue("title"),
-		Content: c.FormValue("content"),
+	// 동시 수정 확인용 메모 버전 (GET /v0.1/memo/:id 응답의 ETag)
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
 	}
 
-	// Rating 파싱 (optional)
-	if ratingStr := c.FormValue("rating"); ratingStr != "" {
-		if rating, err := strconv.ParseUint(ratingStr, 10, 8); err == nil {
-			req.Rating = uint8(rating)
+	var req request.ReqUpdateMemo
+	var errs common.ValidationErrors
+	jsonBody := isJSONRequest(c)
+	if jsonBody {
+		// JSON 본문 (이미지 파일은 전달할 수 없으므로 image_url 또는 presigned 업로드 사용)
+		errs = bindJSONBody(c, &req)
+	} else {
+		// Form 데이터 파싱 (형식이 잘못된 값은 필드 단위 에러로 모음)
+		form := &formParser{c: c}
+		req.Title = c.FormValue("title")
+		req.Content = c.FormValue("content")
+		req.ImageURL = c.FormValue("image_url")
+		req.RoomID = form.Uint("room_id") // 지정 시 해당 방으로 이동
+		if rating := form.Uint8("rating"); rating != nil {
+			req.Rating = *rating
 		}
-	}
-
-	// IsPinned 파싱 (optional)
-	if pinnedStr := c.FormValue("is_pinned"); pinnedStr != "" {
-		if pinned, err := strconv.ParseBool(pinnedStr); err == nil {
-			req.IsPinned = pinned
+		if pinned := form.Bool("is_pinned"); pinned != nil {
+			req.IsPinned = *pinned
 		}
-	}
-
-	// Latitude 파싱 (optional)
-	if latStr := c.FormValue("latitude"); latStr != "" {
-		if lat, err := strconv.ParseFloat(latStr, 64); err == nil {
-			req.Latitude = &lat
+		req.Latitude = form.Float("latitude")
+		req.Longitude = form.Float("longitude")
+		req.LocationName = form.String("location_name")
+		req.Category = form.String("category")
+		req.VisitedAt = form.Time("visited_at")
+		if wishlist := form.Bool("is_wishlist"); wishlist != nil {
+			req.IsWishlist = *wishlist
 		}
+		req.BusinessName = form.String("business_name")
+		req.BusinessPhone = form.String("business_phone")
+		req.BusinessAddress = form.String("business_address")
+		req.NaverPlaceURL = form.String("naver_place_url")
+
+		// Tags 파싱 (optional, 빈 값으로 전달하면 모든 태그 제거)
+		req.Tags = formTags(c)
+		errs = form.errs
 	}
 
-	// Longitude 파싱 (optional)
-	if lngStr := c.FormValue("longitude"); lngStr != "" {
-		if lng, err := strconv.ParseFloat(lngStr, 64); err == nil {
-			req.Longitude = &lng
-		}
+	// 형식 에러가 없으면 validate 태그 검증
+	if len(errs) == 0 {
+		errs = common.ValidateStruct(req)
 	}
-
-	// LocationName 파싱 (optional)
-	if locName := c.FormValue("location_name"); locName != "" {
-		req.LocationName = &locName
+	if len(errs) > 0 {
+		return validationFailed(c, errs)
 	}
 
-	// 이미지 파일 검증 및 처리
-	fileHeader, err := c.FormFile("image")
-	if err == nil && fileHeader != nil {
-		// 파일 크기 검증
-		if fileHeader.Size > common.Env.MaxFileSize {
-			return c.JSON(http.StatusBadRequest, map[string]string{
-				"error": fmt.Sprintf("file size exceeds maximum allowed size (%d bytes)", common.Env.MaxFileSize),
-			})
+	if !jsonBody {
+		// 이미지 파일 처리 (크기/형식 검증은 저장소 업로드 시 수행)
+		fileHeader, err := c.FormFile("image")
+		if err == nil && fileHeader != nil {
+			// 파일 열기
+			file, err := fileHeader.Open()
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]string{
+					"error": "failed to open image file",
+				})
+			}
+			defer file.Close()
+
+			// Request에 파일 정보 담기 (UseCase에서 S3 업로드 처리)
+			req.ImageFile = file
+			req.ImageHeader = fileHeader
 		}
 
-		// 파일 열기
-		file, err := fileHeader.Open()
+		// 여러 이미지 파일 처리 (images[], captions[]는 같은 순서)
+		images, err := openMemoImages(c, formImageHeaders(c))
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, map[string]string{
 				"error": "failed to open image file",
 			})
 		}
-		defer file.Close()
+		defer closeMemoImages(images)
+		req.Images = images
+	}
+
+	memo, err := h.UseCase.UpdateMemo(ctx, uint(id), userID, version, req)
+	if err != nil {
+		return memoWriteError(c, err)
+	}
+
+	setMemoETag(c, memo)
+	return c.JSON(http.StatusOK, memo)
+}
+
+// PatchMemo 메모 부분 수정 API
+// @Router /v0.1/memo/{id} [patch]
+// @Summary 메모 부분 수정 API (JSON merge-patch)
+// @Description 요청에 포함된 필드만 수정합니다 (방의 owner, editor만 가능, 변경된 필드는 수정 이력으로 기록)
+// @Description 필드를 생략하면 기존 값을 유지하고, null을 보내면 값을 제거합니다 (false, 0, 빈 문자열도 그대로 저장)
+// @Description title, room_id는 null로 보낼 수 없으며, tags를 null 또는 빈 배열로 보내면 모든 태그를 제거합니다
+// @Description If-Match에 메모 조회 시 받은 ETag가 필요하며, 그 사이 메모가 바뀌었으면 현재 메모와 함께 412를 반환합니다
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path int true "메모 ID"
+// @Param If-Match header string true "메모 ETag (예: \"3\", *이면 버전을 확인하지 않음)"
+// @Param request body request.ReqPatchMemo true "수정할 필드"
+// @Success 200 {object} response.ResMemo
+// @Header 200 {string} ETag "수정된 메모 버전"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 412 {object} response.ResMemoConflict
+// @Failure 415 {object} map[string]interface{}
+// @Failure 428 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags memo
+func (h *UpdateMemoHandler) PatchMemo(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid memo id"})
+	}
+
+	// 동시 수정 확인용 메모 버전 (GET /v0.1/memo/:id 응답의 ETag)
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	mediaType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil || (mediaType != echo.MIMEApplicationJSON && mediaType != "application/merge-patch+json") {
+		return c.JSON(http.StatusUnsupportedMediaType, map[string]string{"error": "content type must be application/json or application/merge-patch+json"})
+	}
+
+	// merge-patch 문서는 JSON 객체여야 하며, 알 수 없는 필드는 거부
+	var raw json.RawMessage
+	if err := json.NewDecoder(c.Request().Body).Decode(&raw); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid JSON body"})
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil || fields == nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "patch body must be a JSON object"})
+	}
 
-		// Request에 파일 정보 담기 (UseCase에서 S3 업로드 처리)
-		req.ImageFile = file
-		req.ImageHeader = fileHeader
+	var req request.ReqPatchMemo
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid patch field: " + err.Error()})
 	}
 
-	memo, err := h.UseCase.UpdateMemo(ctx, uint(id), userID, req)
+	memo, err := h.UseCase.PatchMemo(ctx, uint(id), userID, version, req)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return memoWriteError(c, err)
 	}
 
+	setMemoETag(c, memo)
 	return c.JSON(http.StatusOK, memo)
 }
@@ -13,8 +13,36 @@ type IGetMemoHandler interface {
 
 type IUpdateMemoHandler interface {
 	UpdateMemo(c echo.Context) error
+	PatchMemo(c echo.Context) error
 }
 
 type IDeleteMemoHandler interface {
 	DeleteMemo(c echo.Context) error
 }
+
+type ITrashMemoHandler interface {
+	GetTrashList(c echo.Context) error
+	RestoreMemo(c echo.Context) error
+	PurgeMemo(c echo.Context) error
+}
+
+type IMemoRevisionHandler interface {
+	GetRevisions(c echo.Context) error
+	GetRevisionDiff(c echo.Context) error
+	RollbackMemo(c echo.Context) error
+}
+
+type ISearchMemoHandler interface {
+	SearchMemo(c echo.Context) error
+}
+
+type IGeoMemoHandler interface {
+	GetNearbyMemo(c echo.Context) error
+	GetBBoxMemo(c echo.Context) error
+	GetMemoClusters(c echo.Context) error
+}
+
+type IMemoImageHandler interface {
+	ReorderMemoImages(c echo.Context) error
+	DeleteMemoImage(c echo.Context) error
+}
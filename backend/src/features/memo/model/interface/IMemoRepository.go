@@ -3,22 +3,72 @@ package _interface
 import (
 	"context"
 	"main/common/db/mysql"
+	"main/features/memo/model/request"
+	"time"
 )
 
 type ICreateMemoRepository interface {
-	Create(ctx context.Context, memo *mysql.Memo) error
+	GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	Create(ctx context.Context, memo *mysql.Memo, tagNames []string) error
+	IsIssuedImageKey(ctx context.Context, userID uint, key string) (bool, error)
 }
 
 type IGetMemoRepository interface {
-	GetByID(ctx context.Context, id uint, userID uint) (*mysql.Memo, error)
-	GetListByUserID(ctx context.Context, userID uint, roomID *uint, isWishlist *bool) ([]mysql.Memo, error)
+	GetByID(ctx context.Context, id uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	GetListByUserID(ctx context.Context, userID uint, query request.MemoListQuery) ([]mysql.Memo, error)
+	CountByUserID(ctx context.Context, userID uint, query request.MemoListQuery) (int64, error)
 }
 
 type IUpdateMemoRepository interface {
-	Update(ctx context.Context, id uint, userID uint, memo *mysql.Memo) error
-	GetByID(ctx context.Context, id uint, userID uint) (*mysql.Memo, error)
+	GetByID(ctx context.Context, id uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	Update(ctx context.Context, id uint, version uint, memo *mysql.Memo, tagNames []string, cover *mysql.MemoImage, images []mysql.MemoImage, revision func(before, after *mysql.Memo) *mysql.MemoRevision) error
+	Patch(ctx context.Context, id uint, version uint, updates map[string]interface{}, tagNames []string, replaceTags bool, revision func(before, after *mysql.Memo) *mysql.MemoRevision) error
+	IsIssuedImageKey(ctx context.Context, userID uint, memoID uint, key string) (bool, error)
 }
 
 type IDeleteMemoRepository interface {
-	Delete(ctx context.Context, id uint, userID uint) error
+	GetByID(ctx context.Context, id uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	Delete(ctx context.Context, id uint, version uint) error
+}
+
+type ITrashMemoRepository interface {
+	GetTrashList(ctx context.Context, userID uint, query request.ReqGetTrashList) ([]mysql.Memo, int64, error)
+	GetTrashedByID(ctx context.Context, id uint) (*mysql.Memo, error)
+	GetByID(ctx context.Context, id uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	Restore(ctx context.Context, id uint) error
+	GetExpiredTrash(ctx context.Context, deletedBefore time.Time, limit int) ([]mysql.Memo, error)
+	HardDelete(ctx context.Context, ids []uint, version uint) ([]uint, error)
+}
+
+type IMemoRevisionRepository interface {
+	GetByID(ctx context.Context, id uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	GetRevisions(ctx context.Context, memoID uint, query request.ReqGetRevisionList) ([]mysql.MemoRevision, int64, error)
+	GetRevision(ctx context.Context, memoID uint, revisionID uint) (*mysql.MemoRevision, error)
+	Rollback(ctx context.Context, id uint, version uint, updates map[string]interface{}, tagNames []string, replaceTags bool, revision func(before, after *mysql.Memo) *mysql.MemoRevision) error
+}
+
+type ISearchMemoRepository interface {
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	Search(ctx context.Context, userID uint, req request.ReqSearchMemo) ([]mysql.Memo, error)
+	CountSearch(ctx context.Context, userID uint, req request.ReqSearchMemo) (int64, error)
+}
+
+type IGeoMemoRepository interface {
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	GetInArea(ctx context.Context, userID uint, query request.MemoGeoQuery) ([]mysql.Memo, error)
+	CountInArea(ctx context.Context, userID uint, query request.MemoGeoQuery) (int64, error)
+	GetClusters(ctx context.Context, userID uint, query request.MemoGeoQuery, precision int) ([]request.MemoClusterRow, error)
+}
+
+type IMemoImageRepository interface {
+	GetByID(ctx context.Context, id uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	ReorderImages(ctx context.Context, memoID uint, imageIDs []uint) error
+	DeleteImage(ctx context.Context, memoID uint, imageID uint) error
 }
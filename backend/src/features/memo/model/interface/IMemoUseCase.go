@@ -12,13 +12,42 @@ type ICreateMemoUseCase interface {
 
 type IGetMemoUseCase interface {
 	GetMemo(ctx context.Context, memoID uint, userID uint) (*response.ResMemo, error)
-	GetMemoList(ctx context.Context, userID uint, roomID *uint, isWishlist *bool) (*response.ResMemoList, error)
+	GetMemoList(ctx context.Context, userID uint, req request.ReqGetMemoList) (*response.ResMemoList, error)
 }
 
 type IUpdateMemoUseCase interface {
-	UpdateMemo(ctx context.Context, memoID uint, userID uint, req request.ReqUpdateMemo) (*response.ResMemo, error)
+	UpdateMemo(ctx context.Context, memoID uint, userID uint, version uint, req request.ReqUpdateMemo) (*response.ResMemo, error)
+	PatchMemo(ctx context.Context, memoID uint, userID uint, version uint, req request.ReqPatchMemo) (*response.ResMemo, error)
 }
 
 type IDeleteMemoUseCase interface {
-	DeleteMemo(ctx context.Context, memoID uint, userID uint) error
+	DeleteMemo(ctx context.Context, memoID uint, userID uint, version uint) error
+}
+
+type ITrashMemoUseCase interface {
+	GetTrashList(ctx context.Context, userID uint, req request.ReqGetTrashList) (*response.ResTrashMemoList, error)
+	RestoreMemo(ctx context.Context, memoID uint, userID uint) (*response.ResMemo, error)
+	PurgeMemo(ctx context.Context, memoID uint, userID uint, version uint) error
+	PurgeExpiredTrash(ctx context.Context) (*response.ResTrashPurge, error)
+}
+
+type IMemoRevisionUseCase interface {
+	GetRevisions(ctx context.Context, memoID uint, userID uint, req request.ReqGetRevisionList) (*response.ResMemoRevisionList, error)
+	GetRevisionDiff(ctx context.Context, memoID uint, revisionID uint, userID uint, compare string) (*response.ResMemoRevisionDiff, error)
+	RollbackMemo(ctx context.Context, memoID uint, revisionID uint, userID uint, state string) (*response.ResMemo, error)
+}
+
+type ISearchMemoUseCase interface {
+	SearchMemo(ctx context.Context, userID uint, req request.ReqSearchMemo) (*response.ResMemoSearchList, error)
+}
+
+type IGeoMemoUseCase interface {
+	GetNearbyMemo(ctx context.Context, userID uint, req request.ReqNearbyMemo) (*response.ResMemoList, error)
+	GetBBoxMemo(ctx context.Context, userID uint, req request.ReqBBoxMemo) (*response.ResMemoList, error)
+	GetMemoClusters(ctx context.Context, userID uint, req request.ReqMemoClusters) (*response.ResMemoClusterList, error)
+}
+
+type IMemoImageUseCase interface {
+	ReorderMemoImages(ctx context.Context, memoID uint, userID uint, req request.ReqReorderMemoImages) (*response.ResMemo, error)
+	DeleteMemoImage(ctx context.Context, memoID uint, imageID uint, userID uint) error
 }
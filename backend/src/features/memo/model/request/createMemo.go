@@ -1,23 +1,30 @@
 package request
 
-import "mime/multipart"
+import (
+	"mime/multipart"
+	"time"
+)
 
 type ReqCreateMemo struct {
-	RoomID          uint                  `json:"room_id"` // Handler에서 기본값 설정
-	Title           string                `json:"title" binding:"required"`
-	Content         string                `json:"content"`
-	ImageURL        string                `json:"image_url"`
-	ImageFile       multipart.File        `json:"-"` // S3 업로드용 파일
-	ImageHeader     *multipart.FileHeader `json:"-"` // 파일 메타데이터
-	Rating          uint8                 `json:"rating"`
-	IsPinned        bool                  `json:"is_pinned"`
-	Latitude        *float64              `json:"latitude"`
-	Longitude       *float64              `json:"longitude"`
-	LocationName    *string               `json:"location_name"`
-	Category        *string               `json:"category"`
-	IsWishlist      bool                  `json:"is_wishlist"`
-	BusinessName    *string               `json:"business_name"`
-	BusinessPhone   *string               `json:"business_phone"`
-	BusinessAddress *string               `json:"business_address"`
-	NaverPlaceURL   *string               `json:"naver_place_url"`
+	RoomID           *uint                 `json:"room_id" validate:"min=1"` // 없으면 사용자의 기본 방
+	Title            string                `json:"title" validate:"required,max=200"`
+	Content          string                `json:"content"`
+	ImageURL         string                `json:"image_url" validate:"url,max=500"`
+	ImageFile        multipart.File        `json:"-"` // S3 업로드용 파일 (대표 이미지)
+	ImageHeader      *multipart.FileHeader `json:"-"` // 파일 메타데이터
+	Images           []ReqMemoImage        `json:"-"` // images[] 파일 (image 파일이 있으면 그 뒤에 추가)
+	Rating           uint8                 `json:"rating" validate:"max=5"`
+	IsPinned         bool                  `json:"is_pinned"`
+	Latitude         *float64              `json:"latitude" validate:"min=-90,max=90"`
+	Longitude        *float64              `json:"longitude" validate:"min=-180,max=180"`
+	LocationName     *string               `json:"location_name" validate:"max=255"`
+	Category         *string               `json:"category" validate:"max=50"`
+	VisitedAt        *time.Time            `json:"visited_at"`
+	IsWishlist       bool                  `json:"is_wishlist"`
+	BusinessName     *string               `json:"business_name" validate:"max=255"`
+	BusinessPhone    *string               `json:"business_phone" validate:"max=50"`
+	BusinessAddress  *string               `json:"business_address" validate:"max=1000"`
+	NaverPlaceURL    *string               `json:"naver_place_url" validate:"url,max=500"`
+	Tags             []string              `json:"tags"`
+	UsePhotoMetadata bool                  `json:"use_photo_metadata"` // true이면 비어 있는 위도/경도와 방문 일시를 사진 EXIF(GPS, 촬영 시각)로 채움
 }
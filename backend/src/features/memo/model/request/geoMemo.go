@@ -0,0 +1,73 @@
+package request
+
+const (
+	DefaultGeoMemoLimit = 100
+	MaxGeoMemoLimit     = 500
+	MaxNearbyRadiusM    = 50000 // 반경 최대 50km
+)
+
+type ReqNearbyMemo struct {
+	Latitude   float64
+	Longitude  float64
+	RadiusM    float64
+	RoomID     *uint
+	IsWishlist *bool
+	Category   *string
+	Limit      int // 기본 100, 최대 500
+}
+
+type ReqBBoxMemo struct {
+	SouthWestLat float64
+	SouthWestLng float64
+	NorthEastLat float64
+	NorthEastLng float64
+	RoomID       *uint
+	IsWishlist   *bool
+	Category     *string
+	Limit        int // 기본 100, 최대 500
+}
+
+// MemoGeoQuery Repository에 전달하는 영역 기반 메모 조회 조건
+type MemoGeoQuery struct {
+	RoomID          *uint
+	IsWishlist      *bool
+	Category        *string
+	MinLat          float64
+	MinLng          float64
+	MaxLat          float64
+	MaxLng          float64
+	CenterLat       float64  // 거리 계산 기준 좌표
+	CenterLng       float64  // 거리 계산 기준 좌표
+	RadiusM         *float64 // 반경 검색 시에만 설정
+	GeohashPrefixes []string // 영역을 덮는 geohash prefix (nil이면 prefix 필터 없음)
+	Limit           int
+}
+
+const (
+	MaxMapZoom = 22
+	// ClusterIndividualZoom 이 줌 레벨 이상이면 클러스터 대신 개별 메모 반환
+	ClusterIndividualZoom = 17
+)
+
+type ReqMemoClusters struct {
+	SouthWestLat float64
+	SouthWestLng float64
+	NorthEastLat float64
+	NorthEastLng float64
+	Zoom         int
+	RoomID       *uint
+	IsWishlist   *bool
+	Category     *string
+}
+
+// MemoClusterRow 클러스터 집계 조회 결과 (geohash 셀 + 카테고리 단위)
+type MemoClusterRow struct {
+	Cell       string
+	Category   *string
+	Count      int64
+	SumLat     float64
+	SumLng     float64
+	SumRating  float64 // 평점이 있는(rating > 0) 메모의 평점 합
+	RatedCount int64   // 평점이 있는 메모 수
+	MinMemoID  uint
+}
@@ -0,0 +1,55 @@
+package request
+
+const (
+	DefaultMemoListLimit = 20
+	MaxMemoListLimit     = 100
+)
+
+// 메모 목록 정렬 기준
+const (
+	MemoSortCreatedAt = "created_at"
+	MemoSortUpdatedAt = "updated_at"
+	MemoSortRating    = "rating"
+	MemoSortDistance  = "distance"
+)
+
+type ReqGetMemoList struct {
+	RoomID      *uint
+	IsWishlist  *bool
+	Category    *string
+	Tags        []string // 태그 필터
+	TagMatch    string   // any(기본) / all
+	Limit       int      // 기본 20, 최대 100
+	After       string   // 이전 응답의 next_cursor
+	Sort        string   // created_at(기본) / updated_at / rating / distance
+	PinnedFirst bool     // 고정된 메모 우선 (기본 true)
+	Latitude    *float64 // distance 정렬 기준 위도
+	Longitude   *float64 // distance 정렬 기준 경도
+}
+
+// MemoCursor 커서 페이지네이션 위치 (마지막으로 조회한 메모의 정렬 키)
+type MemoCursor struct {
+	IsPinned bool
+	Value    interface{} // 정렬 기준 값 (time.Time / uint8 / float64)
+	ID       uint
+}
+
+// MemoListQuery Repository에 전달하는 메모 목록 조회 조건
+type MemoListQuery struct {
+	RoomID      *uint
+	IsWishlist  *bool
+	Category    *string
+	Tags        []string
+	TagMatch    string
+	Limit       int
+	Sort        string
+	PinnedFirst bool
+	Latitude    float64
+	Longitude   float64
+	Cursor      *MemoCursor
+}
+
+// IsValidMemoSort 지원하는 정렬 기준인지 확인
+func IsValidMemoSort(sort string) bool {
+	return sort == MemoSortCreatedAt || sort == MemoSortUpdatedAt || sort == MemoSortRating || sort == MemoSortDistance
+}
@@ -0,0 +1,20 @@
+package request
+
+import "mime/multipart"
+
+const (
+	MaxMemoImages          = 10  // 메모당 최대 이미지 수
+	MaxMemoImageCaptionLen = 255 // 이미지 설명 최대 길이
+)
+
+// ReqMemoImage 업로드할 메모 이미지 (multipart images[] 파트, captions[]와 같은 순서)
+type ReqMemoImage struct {
+	File    multipart.File
+	Header  *multipart.FileHeader
+	Caption *string
+}
+
+// ReqReorderMemoImages 메모 이미지 순서 변경 (메모의 모든 이미지 ID를 원하는 순서로 전달, 첫 번째가 대표 이미지)
+type ReqReorderMemoImages struct {
+	ImageIDs []uint `json:"image_ids"`
+}
@@ -0,0 +1,18 @@
+package request
+
+// 수정 이력 diff 비교 대상
+const (
+	RevisionComparePrevious = "previous" // 이 수정의 전후 비교 (기본)
+	RevisionCompareCurrent  = "current"  // 이 수정 직후와 현재 메모 비교
+)
+
+// 롤백 기준 상태
+const (
+	RevisionStateAfter  = "after"  // 이 수정 직후 상태로 되돌림 (기본)
+	RevisionStateBefore = "before" // 이 수정 직전 상태로 되돌림 (이 수정만 취소)
+)
+
+type ReqGetRevisionList struct {
+	Limit  int // 기본 20, 최대 100
+	Offset int
+}
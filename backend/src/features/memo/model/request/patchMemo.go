@@ -0,0 +1,47 @@
+package request
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PatchField JSON merge-patch 필드 (요청에 없으면 Set=false로 기존 값 유지, null이면 Null=true로 값 제거)
+type PatchField[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+// UnmarshalJSON 필드가 요청 본문에 있을 때만 호출되므로 Set으로 전달 여부를 기록
+func (f *PatchField[T]) UnmarshalJSON(data []byte) error {
+	f.Set = true
+	if string(data) == "null" {
+		f.Null = true
+		var zero T
+		f.Value = zero
+		return nil
+	}
+	return json.Unmarshal(data, &f.Value)
+}
+
+// ReqPatchMemo 메모 부분 수정 요청 (RFC 7396 JSON merge-patch)
+// 없는 필드는 유지, null은 값 제거 (NOT NULL 컬럼은 기본값으로 초기화, title/room_id는 null 불가)
+type ReqPatchMemo struct {
+	RoomID          PatchField[uint]      `json:"room_id"` // 지정 시 해당 방으로 메모 이동
+	Title           PatchField[string]    `json:"title"`
+	Content         PatchField[string]    `json:"content"`
+	ImageURL        PatchField[string]    `json:"image_url"` // null이면 대표 이미지 제거 (memo_images는 유지)
+	Rating          PatchField[uint8]     `json:"rating"`
+	IsPinned        PatchField[bool]      `json:"is_pinned"`
+	Latitude        PatchField[float64]   `json:"latitude"`
+	Longitude       PatchField[float64]   `json:"longitude"`
+	LocationName    PatchField[string]    `json:"location_name"`
+	Category        PatchField[string]    `json:"category"`
+	VisitedAt       PatchField[time.Time] `json:"visited_at"` // RFC3339
+	IsWishlist      PatchField[bool]      `json:"is_wishlist"`
+	BusinessName    PatchField[string]    `json:"business_name"`
+	BusinessPhone   PatchField[string]    `json:"business_phone"`
+	BusinessAddress PatchField[string]    `json:"business_address"`
+	NaverPlaceURL   PatchField[string]    `json:"naver_place_url"`
+	Tags            PatchField[[]string]  `json:"tags"` // null 또는 빈 배열이면 모든 태그 제거
+}
@@ -0,0 +1,147 @@
+package request
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPatchFieldUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want PatchField[string]
+	}{
+		{name: "absent", body: `{}`, want: PatchField[string]{}},
+		{name: "null", body: `{"v":null}`, want: PatchField[string]{Set: true, Null: true}},
+		{name: "value", body: `{"v":"hello"}`, want: PatchField[string]{Set: true, Value: "hello"}},
+		{name: "empty string", body: `{"v":""}`, want: PatchField[string]{Set: true, Value: ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req struct {
+				V PatchField[string] `json:"v"`
+			}
+			if err := json.Unmarshal([]byte(tt.body), &req); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if req.V != tt.want {
+				t.Fatalf("PatchField = %+v, want %+v", req.V, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatchFieldNullClearsPreviousValue(t *testing.T) {
+	field := PatchField[float64]{Value: 37.5}
+	if err := json.Unmarshal([]byte(`null`), &field); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !field.Set || !field.Null || field.Value != 0 {
+		t.Fatalf("PatchField = %+v, want {Set: true, Null: true, Value: 0}", field)
+	}
+}
+
+func TestPatchFieldInvalidType(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		dst  interface{}
+	}{
+		{name: "string for bool", body: `{"is_pinned":"true"}`, dst: &ReqPatchMemo{}},
+		{name: "negative rating", body: `{"rating":-1}`, dst: &ReqPatchMemo{}},
+		{name: "rating overflow", body: `{"rating":256}`, dst: &ReqPatchMemo{}},
+		{name: "non RFC3339 time", body: `{"visited_at":"2026-10-18"}`, dst: &ReqPatchMemo{}},
+		{name: "string for tags", body: `{"tags":"food"}`, dst: &ReqPatchMemo{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.body), tt.dst); err == nil {
+				t.Fatalf("json.Unmarshal(%s) want error", tt.body)
+			}
+		})
+	}
+}
+
+func TestReqPatchMemoUnmarshal(t *testing.T) {
+	visitedAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	var req ReqPatchMemo
+	body := `{
+		"title": "new title",
+		"content": "",
+		"rating": 0,
+		"is_pinned": false,
+		"latitude": null,
+		"longitude": 127.0,
+		"category": null,
+		"visited_at": "2026-10-18T12:00:00Z",
+		"tags": []
+	}`
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	// 요청에 있는 값은 false, 0, 빈 문자열이어도 Set
+	if req.Title != (PatchField[string]{Set: true, Value: "new title"}) {
+		t.Errorf("Title = %+v", req.Title)
+	}
+	if req.Content != (PatchField[string]{Set: true, Value: ""}) {
+		t.Errorf("Content = %+v", req.Content)
+	}
+	if req.Rating != (PatchField[uint8]{Set: true, Value: 0}) {
+		t.Errorf("Rating = %+v", req.Rating)
+	}
+	if req.IsPinned != (PatchField[bool]{Set: true, Value: false}) {
+		t.Errorf("IsPinned = %+v", req.IsPinned)
+	}
+	if req.Longitude != (PatchField[float64]{Set: true, Value: 127.0}) {
+		t.Errorf("Longitude = %+v", req.Longitude)
+	}
+	if !req.VisitedAt.Set || req.VisitedAt.Null || !req.VisitedAt.Value.Equal(visitedAt) {
+		t.Errorf("VisitedAt = %+v", req.VisitedAt)
+	}
+
+	// null은 Set + Null
+	if req.Latitude != (PatchField[float64]{Set: true, Null: true}) {
+		t.Errorf("Latitude = %+v", req.Latitude)
+	}
+	if req.Category != (PatchField[string]{Set: true, Null: true}) {
+		t.Errorf("Category = %+v", req.Category)
+	}
+
+	// 빈 배열은 null이 아닌 빈 값 (모든 태그 제거)
+	if !req.Tags.Set || req.Tags.Null || req.Tags.Value == nil || len(req.Tags.Value) != 0 {
+		t.Errorf("Tags = %+v, want set empty slice", req.Tags)
+	}
+
+	// 요청에 없는 필드는 Set=false (기존 값 유지)
+	absent := map[string]bool{
+		"RoomID":          req.RoomID.Set,
+		"ImageURL":        req.ImageURL.Set,
+		"LocationName":    req.LocationName.Set,
+		"IsWishlist":      req.IsWishlist.Set,
+		"BusinessName":    req.BusinessName.Set,
+		"BusinessPhone":   req.BusinessPhone.Set,
+		"BusinessAddress": req.BusinessAddress.Set,
+		"NaverPlaceURL":   req.NaverPlaceURL.Set,
+	}
+	for field, set := range absent {
+		if set {
+			t.Errorf("%s.Set = true for absent field", field)
+		}
+	}
+}
+
+func TestReqPatchMemoTagsNull(t *testing.T) {
+	var req ReqPatchMemo
+	if err := json.Unmarshal([]byte(`{"tags":null}`), &req); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(req.Tags, PatchField[[]string]{Set: true, Null: true}) {
+		t.Fatalf("Tags = %+v, want {Set: true, Null: true}", req.Tags)
+	}
+}
@@ -0,0 +1,16 @@
+package request
+
+const (
+	MinSearchQueryLength = 2 // n-gram 토큰 크기 (ngram_token_size)
+	MaxSearchQueryLength = 100
+)
+
+type ReqSearchMemo struct {
+	Query      string // 검색어 (q)
+	RoomID     *uint
+	IsWishlist *bool
+	Tags       []string // 태그 필터
+	TagMatch   string   // any(기본) / all
+	Limit      int      // 기본 20, 최대 100
+	Offset     int
+}
@@ -0,0 +1,50 @@
+package request
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	MaxTagsPerMemo = 20
+	MaxTagLength   = 50
+)
+
+// 태그 필터 방식
+const (
+	TagMatchAny = "any" // 태그 중 하나라도 포함
+	TagMatchAll = "all" // 태그를 모두 포함
+)
+
+// NormalizeTags 태그 이름 정리 (앞뒤/연속 공백 제거, 대소문자 무시 중복 제거) 및 개수/길이 검증
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		name := strings.Join(strings.Fields(tag), " ")
+		if name == "" {
+			continue
+		}
+		if utf8.RuneCountInString(name) > MaxTagLength {
+			return nil, fmt.Errorf("tag exceeds maximum length of %d characters", MaxTagLength)
+		}
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, name)
+	}
+
+	if len(result) > MaxTagsPerMemo {
+		return nil, fmt.Errorf("a memo can have at most %d tags", MaxTagsPerMemo)
+	}
+
+	return result, nil
+}
+
+// IsValidTagMatch 지원하는 태그 필터 방식인지 확인
+func IsValidTagMatch(mode string) bool {
+	return mode == TagMatchAny || mode == TagMatchAll
+}
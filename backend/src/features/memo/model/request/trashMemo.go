@@ -0,0 +1,7 @@
+package request
+
+type ReqGetTrashList struct {
+	RoomID *uint // 방 필터
+	Limit  int   // 기본 20, 최대 100
+	Offset int
+}
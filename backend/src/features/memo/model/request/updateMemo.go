@@ -1,20 +1,29 @@
 package request
 
-import "mime/multipart"
+import (
+	"mime/multipart"
+	"time"
+)
 
 type ReqUpdateMemo struct {
-	Title           string                `json:"title"`
+	RoomID          *uint                 `json:"room_id" validate:"min=1"` // 지정 시 해당 방으로 메모 이동
+	Title           string                `json:"title" validate:"max=200"`
 	Content         string                `json:"content"`
-	ImageURL        string                `json:"image_url"`
-	ImageFile       multipart.File        `json:"-"` // S3 업로드용 파일
+	ImageURL        string                `json:"image_url" validate:"url,max=500"`
+	ImageFile       multipart.File        `json:"-"` // S3 업로드용 파일 (대표 이미지 교체)
 	ImageHeader     *multipart.FileHeader `json:"-"` // 파일 메타데이터
-	Rating          uint8                 `json:"rating"`
+	Images          []ReqMemoImage        `json:"-"` // images[] 파일 (기존 이미지 뒤에 추가)
+	Rating          uint8                 `json:"rating" validate:"max=5"`
 	IsPinned        bool                  `json:"is_pinned"`
-	Latitude        *float64              `json:"latitude"`
-	Longitude       *float64              `json:"longitude"`
-	LocationName    *string               `json:"location_name"`
+	Latitude        *float64              `json:"latitude" validate:"min=-90,max=90"`
+	Longitude       *float64              `json:"longitude" validate:"min=-180,max=180"`
+	LocationName    *string               `json:"location_name" validate:"max=255"`
+	Category        *string               `json:"category" validate:"max=50"`
+	VisitedAt       *time.Time            `json:"visited_at"`
 	IsWishlist      bool                  `json:"is_wishlist"`
-	BusinessName    *string               `json:"business_name"`
-	BusinessPhone   *string               `json:"business_phone"`
-	BusinessAddress *string               `json:"business_address"`
+	BusinessName    *string               `json:"business_name" validate:"max=255"`
+	BusinessPhone   *string               `json:"business_phone" validate:"max=50"`
+	BusinessAddress *string               `json:"business_address" validate:"max=1000"`
+	NaverPlaceURL   *string               `json:"naver_place_url" validate:"url,max=500"`
+	Tags            []string              `json:"tags"` // nil이면 유지, 빈 배열이면 모든 태그 제거
 }
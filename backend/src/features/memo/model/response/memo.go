@@ -8,27 +8,76 @@ import (
 type ResMemo struct {
 	ID              uint                             `json:"id"`
 	UserID          uint                             `json:"user_id"`
+	RoomID          uint                             `json:"room_id"`
 	Title           string                           `json:"title"`
 	Content         string                           `json:"content"`
 	ImageURL        string                           `json:"image_url"`
+	ImageMediumURL    string                         `json:"image_medium_url"`    // 대표 이미지 중간 크기 (없으면 image_url)
+	ImageThumbnailURL string                         `json:"image_thumbnail_url"` // 대표 이미지 썸네일 (없으면 image_url)
 	Rating          uint8                            `json:"rating"`
 	IsPinned        bool                             `json:"is_pinned"`
 	Latitude        *float64                         `json:"latitude"`
 	Longitude       *float64                         `json:"longitude"`
 	LocationName    *string                          `json:"location_name"`
 	Category        *string                          `json:"category"`
+	VisitedAt       *time.Time                       `json:"visited_at,omitempty"` // 방문 일시
 	// Wishlist fields (Issue #19)
 	IsWishlist      bool                             `json:"is_wishlist"`
 	BusinessName    *string                          `json:"business_name,omitempty"`
 	BusinessPhone   *string                          `json:"business_phone,omitempty"`
 	BusinessAddress *string                          `json:"business_address,omitempty"`
 	NaverPlaceURL   *string                          `json:"naver_place_url,omitempty"`
+	Version         uint                             `json:"version"` // 메모 버전 (ETag와 같은 값, 수정 시 If-Match로 전달)
+	Distance        *float64                         `json:"distance_m,omitempty"` // 기준 좌표와의 거리(m), 거리 기반 조회 시에만 포함
+	Tags            []string                         `json:"tags"`
+	Images          []ResMemoImage                   `json:"images"` // 정렬 순서대로, 첫 번째가 대표 이미지(image_url)
 	Comments        []commentResponse.ResComment     `json:"comments,omitempty"`
 	CreatedAt       time.Time                        `json:"created_at"`
 	UpdatedAt       time.Time                        `json:"updated_at"`
 }
 
+type ResMemoImage struct {
+	ID           uint    `json:"id"`
+	ImageURL     string  `json:"image_url"`     // 원본 크기
+	MediumURL    string  `json:"medium_url"`    // 긴 변 1280px (없으면 원본)
+	ThumbnailURL string  `json:"thumbnail_url"` // 긴 변 320px (없으면 원본)
+	SortOrder    int     `json:"sort_order"`
+	Caption      *string `json:"caption,omitempty"`
+	Width        int     `json:"width"`
+	Height       int     `json:"height"`
+}
+
 type ResMemoList struct {
-	Memos []ResMemo `json:"memos"`
-	Total int64     `json:"total"`
+	Memos      []ResMemo `json:"memos"`
+	Total      int64     `json:"total"`                 // 필터 조건에 맞는 전체 메모 수
+	NextCursor string    `json:"next_cursor,omitempty"` // 다음 페이지 조회 시 after 파라미터로 전달
+	HasMore    bool      `json:"has_more"`
+}
+
+type ResMemoSearchResult struct {
+	Memo       ResMemo           `json:"memo"`
+	Relevance  float64           `json:"relevance"`            // 관련도 점수 (높을수록 관련성 높음)
+	Highlights map[string]string `json:"highlights,omitempty"` // 필드명 -> 검색어가 <em>으로 강조된 발췌문
+}
+
+type ResMemoSearchList struct {
+	Results []ResMemoSearchResult `json:"results"`
+	Total   int64                 `json:"total"`
+}
+
+type ResMemoCluster struct {
+	Geohash          string   `json:"geohash"`
+	Count            int64    `json:"count"`
+	Latitude         float64  `json:"latitude"`                    // 클러스터에 포함된 메모 좌표의 평균
+	Longitude        float64  `json:"longitude"`                   // 클러스터에 포함된 메모 좌표의 평균
+	DominantCategory *string  `json:"dominant_category,omitempty"` // 가장 많은 카테고리
+	AverageRating    *float64 `json:"average_rating,omitempty"`    // 평점이 있는 메모의 평균 평점
+	MemoID           *uint    `json:"memo_id,omitempty"`           // 메모가 1개인 클러스터의 메모 ID
+}
+
+type ResMemoClusterList struct {
+	Zoom     int              `json:"zoom"`
+	Clusters []ResMemoCluster `json:"clusters"`
+	Memos    []ResMemo        `json:"memos,omitempty"` // 높은 줌 레벨에서만 개별 메모 반환
+	Total    int64            `json:"total"`           // 영역 안의 전체 메모 수
 }
@@ -0,0 +1,24 @@
+package response
+
+// ResMemoConflict If-Match 버전 불일치 응답 (412, 클라이언트가 다시 병합할 수 있도록 현재 메모 포함)
+// error를 구현하므로 UseCase에서 에러로 반환하고 핸들러에서 그대로 응답 본문으로 씀
+type ResMemoConflict struct {
+	ErrType string   `json:"errType"`
+	Msg     string   `json:"msg"`
+	Current *ResMemo `json:"current"`
+	err     error
+}
+
+// NewMemoConflict 버전 불일치 에러 생성 (err는 common.ErrorMsg로 만든 에러)
+func NewMemoConflict(errType string, msg string, err error, current *ResMemo) *ResMemoConflict {
+	return &ResMemoConflict{
+		ErrType: errType,
+		Msg:     msg,
+		Current: current,
+		err:     err,
+	}
+}
+
+func (r *ResMemoConflict) Error() string {
+	return r.err.Error()
+}
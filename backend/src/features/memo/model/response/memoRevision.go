@@ -0,0 +1,33 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ResMemoRevision struct {
+	ID            uint      `json:"id"`
+	MemoID        uint      `json:"memo_id"`
+	EditorID      uint      `json:"editor_id"`
+	EditorName    string    `json:"editor_name"`
+	ChangedFields []string  `json:"changed_fields"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type ResMemoRevisionList struct {
+	Revisions []ResMemoRevision `json:"revisions"` // 최근 수정순
+	Total     int64             `json:"total"`
+}
+
+// ResMemoFieldDiff 필드 단위 변경 내용 (값은 필드 타입 그대로의 JSON, 값이 없으면 null)
+type ResMemoFieldDiff struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before" swaggertype:"object"`
+	After  json.RawMessage `json:"after" swaggertype:"object"`
+}
+
+type ResMemoRevisionDiff struct {
+	Revision ResMemoRevision    `json:"revision"`
+	Compare  string             `json:"compare"` // previous: 이 수정의 전후 비교, current: 이 수정 직후와 현재 메모 비교
+	Changes  []ResMemoFieldDiff `json:"changes"`
+}
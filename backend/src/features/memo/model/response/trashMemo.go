@@ -0,0 +1,22 @@
+package response
+
+import "time"
+
+// ResTrashMemo 휴지통 메모 (purge_at이 지나면 댓글, 이미지와 함께 영구 삭제)
+type ResTrashMemo struct {
+	ResMemo
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+type ResTrashMemoList struct {
+	Memos []ResTrashMemo `json:"memos"`
+	Total int64          `json:"total"`
+}
+
+// ResTrashPurge 휴지통 영구 삭제 결과
+type ResTrashPurge struct {
+	Purged        int `json:"purged"`         // 영구 삭제한 메모 수
+	DeletedImages int `json:"deleted_images"` // 삭제한 이미지 파일 수
+	FailedImages  int `json:"failed_images"`  // 삭제하지 못한 이미지 파일 수 (이미지 정리 작업에서 다시 정리됨)
+}
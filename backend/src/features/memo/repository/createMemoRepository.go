@@ -18,8 +18,50 @@ func NewCreateMemoRepository(gormDB *gorm.DB) _interface.ICreateMemoRepository {
 	}
 }
 
-// Create 메모 생성
-func (r *CreateMemoRepository) Create(ctx context.Context, memo *mysql.Memo) error {
-	result := r.GormDB.WithContext(ctx).Create(memo)
-	return result.Error
+// GetDefaultRoomID 사용자의 기본 방 ID 조회
+func (r *CreateMemoRepository) GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error) {
+	var user mysql.User
+	result := r.GormDB.WithContext(ctx).
+		Select("id", "default_room_id").
+		Where("id = ?", userID).
+		First(&user)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return user.DefaultRoomID, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *CreateMemoRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// IsIssuedImageKey key가 사용자가 완료 처리한 메모 업로드의 객체 키인지 확인 (image_url로 받은 대표 이미지 검증용)
+func (r *CreateMemoRepository) IsIssuedImageKey(ctx context.Context, userID uint, key string) (bool, error) {
+	return isIssuedImageKey(r.GormDB.WithContext(ctx), userID, 0, key)
+}
+
+// Create 메모 생성 (memo.Images도 함께 저장, 태그가 있으면 방의 태그로 연결하고 없는 태그는 생성)
+func (r *CreateMemoRepository) Create(ctx context.Context, memo *mysql.Memo, tagNames []string) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(memo).Error; err != nil {
+			return err
+		}
+
+		if len(tagNames) == 0 {
+			return nil
+		}
+		return replaceMemoTags(tx, memo, tagNames)
+	})
 }
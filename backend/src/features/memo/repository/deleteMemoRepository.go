@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/common/db/mysql"
 	_interface "main/features/memo/model/interface"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -18,19 +19,58 @@ func NewDeleteMemoRepository(gormDB *gorm.DB) _interface.IDeleteMemoRepository {
 	}
 }
 
-// Delete 메모 삭제 (Soft Delete)
-func (r *DeleteMemoRepository) Delete(ctx context.Context, id uint, userID uint) error {
+// Delete 메모를 휴지통으로 이동 (Soft Delete)
+// 메모의 댓글도 함께 숨기고 deleted_with_memo로 표시하므로 복원 시 함께 복원됨 (이미 삭제된 댓글은 제외)
+// version이 0이 아니면 현재 버전이 같을 때만 삭제 (다르면 ErrRecordNotFound)
+func (r *DeleteMemoRepository) Delete(ctx context.Context, id uint, version uint) error {
+	deletedAt := time.Now()
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := whereMemoVersion(tx.Model(&mysql.Memo{}).Where("id = ?", id), version).
+			UpdateColumn("deleted_at", deletedAt)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Model(&mysql.Comment{}).
+			Where("memo_id = ?", id).
+			UpdateColumns(map[string]interface{}{
+				"deleted_at":        deletedAt,
+				"deleted_with_memo": true,
+			}).Error
+	})
+}
+
+// GetByID 특정 메모 조회 (권한 확인 및 버전 불일치 응답용, 태그 및 이미지 포함)
+func (r *DeleteMemoRepository) GetByID(ctx context.Context, id uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
 	result := r.GormDB.WithContext(ctx).
-		Where("id = ? AND user_id = ?", id, userID).
-		Delete(&mysql.Memo{})
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Where("id = ?", id).
+		First(&memo)
 
 	if result.Error != nil {
-		return result.Error
+		return nil, result.Error
 	}
 
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+	return &memo, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *DeleteMemoRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
 	}
 
-	return nil
+	return &member, nil
 }
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"main/common/db/mysql"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type GeoMemoRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewGeoMemoRepository(gormDB *gorm.DB) _interface.IGeoMemoRepository {
+	return &GeoMemoRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *GeoMemoRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetInArea 영역(반경 또는 사각형) 안의 메모를 기준 좌표와 가까운 순으로 조회
+func (r *GeoMemoRepository) GetInArea(ctx context.Context, userID uint, query request.MemoGeoQuery) ([]mysql.Memo, error) {
+	var memos []mysql.Memo
+	distanceExpr := memoDistanceExpr(query.CenterLat, query.CenterLng)
+	result := applyGeoFilter(r.GormDB.WithContext(ctx).Model(&mysql.Memo{}), userID, query).
+		Select("memos.*, "+distanceExpr+" AS distance").
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Order("distance ASC").
+		Order("memos.id DESC").
+		Limit(query.Limit).
+		Find(&memos)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return memos, nil
+}
+
+// CountInArea 영역 안의 전체 메모 수 조회
+func (r *GeoMemoRepository) CountInArea(ctx context.Context, userID uint, query request.MemoGeoQuery) (int64, error) {
+	var total int64
+	result := applyGeoFilter(r.GormDB.WithContext(ctx).Model(&mysql.Memo{}), userID, query).Count(&total)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return total, nil
+}
+
+// GetClusters 영역 안의 메모를 geohash 셀(precision 글자) + 카테고리 단위로 집계
+func (r *GeoMemoRepository) GetClusters(ctx context.Context, userID uint, query request.MemoGeoQuery, precision int) ([]request.MemoClusterRow, error) {
+	var rows []request.MemoClusterRow
+	cellExpr := fmt.Sprintf("LEFT(memos.geohash, %d)", precision)
+	result := applyGeoFilter(r.GormDB.WithContext(ctx).Model(&mysql.Memo{}), userID, query).
+		Select(cellExpr + " AS cell, memos.category AS category, COUNT(*) AS count, " +
+			"SUM(memos.latitude) AS sum_lat, SUM(memos.longitude) AS sum_lng, " +
+			"COALESCE(SUM(CASE WHEN memos.rating > 0 THEN memos.rating ELSE 0 END), 0) AS sum_rating, " +
+			"SUM(CASE WHEN memos.rating > 0 THEN 1 ELSE 0 END) AS rated_count, " +
+			"MIN(memos.id) AS min_memo_id").
+		Group(cellExpr).
+		Group("memos.category").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return rows, nil
+}
+
+// applyGeoFilter geohash prefix(인덱스) + 좌표 범위 + 반경 + 방/위시리스트/카테고리 필터 적용
+func applyGeoFilter(db *gorm.DB, userID uint, query request.MemoGeoQuery) *gorm.DB {
+	db = db.Where("memos.room_id IN (SELECT room_id FROM room_members WHERE user_id = ?)", userID)
+
+	if len(query.GeohashPrefixes) > 0 {
+		conditions := make([]string, len(query.GeohashPrefixes))
+		args := make([]interface{}, len(query.GeohashPrefixes))
+		for i, prefix := range query.GeohashPrefixes {
+			conditions[i] = "memos.geohash LIKE ?"
+			args[i] = prefix + "%"
+		}
+		db = db.Where("("+strings.Join(conditions, " OR ")+")", args...)
+	} else {
+		db = db.Where("memos.geohash IS NOT NULL")
+	}
+
+	db = db.Where("memos.latitude BETWEEN ? AND ? AND memos.longitude BETWEEN ? AND ?",
+		query.MinLat, query.MaxLat, query.MinLng, query.MaxLng)
+
+	if query.RadiusM != nil {
+		db = db.Where(memoDistanceExpr(query.CenterLat, query.CenterLng)+" <= ?", *query.RadiusM)
+	}
+
+	if query.RoomID != nil {
+		db = db.Where("memos.room_id = ?", *query.RoomID)
+	}
+
+	if query.IsWishlist != nil {
+		db = db.Where("memos.is_wishlist = ?", *query.IsWishlist)
+	}
+
+	if query.Category != nil {
+		db = db.Where("memos.category = ?", *query.Category)
+	}
+
+	return db
+}
+
+// memoDistanceExpr 기준 좌표와 메모 사이 거리(m) SQL 표현식 (좌표는 파싱된 float 값이므로 SQL에 직접 포함)
+func memoDistanceExpr(lat, lng float64) string {
+	return fmt.Sprintf("ST_Distance_Sphere(POINT(memos.longitude, memos.latitude), POINT(%s, %s))",
+		strconv.FormatFloat(lng, 'f', -1, 64), strconv.FormatFloat(lat, 'f', -1, 64))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/common/db/mysql"
 	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
 
 	"gorm.io/gorm"
 )
@@ -18,12 +19,14 @@ func NewGetMemoRepository(gormDB *gorm.DB) _interface.IGetMemoRepository {
 	}
 }
 
-// GetByID 특정 메모 조회 (댓글 포함)
-func (r *GetMemoRepository) GetByID(ctx context.Context, id uint, userID uint) (*mysql.Memo, error) {
+// GetByID 특정 메모 조회 (댓글, 태그, 이미지 포함)
+func (r *GetMemoRepository) GetByID(ctx context.Context, id uint) (*mysql.Memo, error) {
 	var memo mysql.Memo
 	result := r.GormDB.WithContext(ctx).
 		Preload("Comments.User").
-		Where("id = ? AND user_id = ?", id, userID).
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Where("id = ?", id).
 		First(&memo)
 
 	if result.Error != nil {
@@ -33,26 +36,113 @@ func (r *GetMemoRepository) GetByID(ctx context.Context, id uint, userID uint) (
 	return &memo, nil
 }
 
-// GetListByUserID 사용자의 메모 목록 조회 (Room ID 및 위시리스트 필터 옵션 포함)
-func (r *GetMemoRepository) GetListByUserID(ctx context.Context, userID uint, roomID *uint, isWishlist *bool) ([]mysql.Memo, error) {
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *GetMemoRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetListByUserID 사용자가 멤버인 방들의 메모 목록 조회 (필터, 정렬, 커서 페이지네이션 적용)
+func (r *GetMemoRepository) GetListByUserID(ctx context.Context, userID uint, query request.MemoListQuery) ([]mysql.Memo, error) {
 	var memos []mysql.Memo
-	query := r.GormDB.WithContext(ctx).Where("user_id = ?", userID)
+	db := applyMemoListFilter(r.GormDB.WithContext(ctx).Model(&mysql.Memo{}), userID, query)
 
-	// roomID 필터 적용
-	if roomID != nil {
-		query = query.Where("room_id = ?", *roomID)
+	sortExpr, ascending := memoSortExpr(query)
+	if query.Sort == request.MemoSortDistance {
+		db = db.Select("memos.*, " + sortExpr + " AS distance")
 	}
 
-	// isWishlist 필터 적용
-	if isWishlist != nil {
-		query = query.Where("is_wishlist = ?", *isWishlist)
+	// 커서 이후 데이터만 조회 (정렬 키, id 순서 기준 keyset)
+	if query.Cursor != nil {
+		cmp := "<"
+		if ascending {
+			cmp = ">"
+		}
+		keyCond := "(" + sortExpr + " " + cmp + " ? OR (" + sortExpr + " = ? AND memos.id < ?))"
+		if query.PinnedFirst {
+			db = db.Where("(memos.is_pinned < ? OR (memos.is_pinned = ? AND "+keyCond+"))",
+				query.Cursor.IsPinned, query.Cursor.IsPinned, query.Cursor.Value, query.Cursor.Value, query.Cursor.ID)
+		} else {
+			db = db.Where(keyCond, query.Cursor.Value, query.Cursor.Value, query.Cursor.ID)
+		}
 	}
 
-	result := query.Order("is_pinned DESC, created_at DESC").Find(&memos)
+	if query.PinnedFirst {
+		db = db.Order("memos.is_pinned DESC")
+	}
+	direction := " DESC"
+	if ascending {
+		direction = " ASC"
+	}
+	db = db.Order(sortExpr + direction).Order("memos.id DESC")
 
+	result := db.Preload("Tags").Preload("Images", orderMemoImages).Limit(query.Limit).Find(&memos)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 
 	return memos, nil
 }
+
+// CountByUserID 필터 조건에 맞는 전체 메모 수 조회 (커서와 무관)
+func (r *GetMemoRepository) CountByUserID(ctx context.Context, userID uint, query request.MemoListQuery) (int64, error) {
+	var total int64
+	result := applyMemoListFilter(r.GormDB.WithContext(ctx).Model(&mysql.Memo{}), userID, query).Count(&total)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return total, nil
+}
+
+// applyMemoListFilter 사용자가 멤버인 방 + Room ID / 위시리스트 / 카테고리 / 태그 필터 적용
+func applyMemoListFilter(db *gorm.DB, userID uint, query request.MemoListQuery) *gorm.DB {
+	db = db.Where("memos.room_id IN (SELECT room_id FROM room_members WHERE user_id = ?)", userID)
+
+	// roomID 필터 적용
+	if query.RoomID != nil {
+		db = db.Where("memos.room_id = ?", *query.RoomID)
+	}
+
+	// isWishlist 필터 적용
+	if query.IsWishlist != nil {
+		db = db.Where("memos.is_wishlist = ?", *query.IsWishlist)
+	}
+
+	// category 필터 적용
+	if query.Category != nil {
+		db = db.Where("memos.category = ?", *query.Category)
+	}
+
+	// 태그 필터 적용
+	db = applyTagFilter(db, query.Tags, query.TagMatch)
+
+	// 거리순 정렬은 좌표가 있는 메모만 대상
+	if query.Sort == request.MemoSortDistance {
+		db = db.Where("memos.latitude IS NOT NULL AND memos.longitude IS NOT NULL")
+	}
+
+	return db
+}
+
+// memoSortExpr 정렬 기준별 SQL 표현식과 정렬 방향 (거리순만 오름차순)
+func memoSortExpr(query request.MemoListQuery) (string, bool) {
+	switch query.Sort {
+	case request.MemoSortUpdatedAt:
+		return "memos.updated_at", false
+	case request.MemoSortRating:
+		return "memos.rating", false
+	case request.MemoSortDistance:
+		return memoDistanceExpr(query.Latitude, query.Longitude), true
+	default:
+		return "memos.created_at", false
+	}
+}
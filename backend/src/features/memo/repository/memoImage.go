@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+// orderMemoImages 이미지 Preload 정렬 (정렬 순서가 같으면 먼저 추가된 이미지 우선)
+func orderMemoImages(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order ASC, id ASC")
+}
+
+// AppendMemoImages 기존 이미지 뒤에 이미지 추가 (presigned 업로드 완료 처리에서도 사용)
+func AppendMemoImages(tx *gorm.DB, memoID uint, images []mysql.MemoImage) error {
+	if len(images) == 0 {
+		return nil
+	}
+
+	var maxOrder sql.NullInt64
+	if err := tx.Model(&mysql.MemoImage{}).
+		Select("MAX(sort_order)").
+		Where("memo_id = ?", memoID).
+		Scan(&maxOrder).Error; err != nil {
+		return err
+	}
+
+	next := 0
+	if maxOrder.Valid {
+		next = int(maxOrder.Int64) + 1
+	}
+	for i := range images {
+		images[i].MemoID = memoID
+		images[i].SortOrder = next + i
+	}
+
+	return tx.Create(&images).Error
+}
+
+// isIssuedImageKey key가 서버가 발급한 메모 이미지 객체인지 확인
+// memoID 메모에 이미 있는 이미지이거나 userID가 완료 처리한 메모 업로드인 경우만 해당 (다른 사용자의 객체 키는 제외)
+func isIssuedImageKey(db *gorm.DB, userID uint, memoID uint, key string) (bool, error) {
+	var count int64
+	if memoID != 0 {
+		if err := db.Model(&mysql.MemoImage{}).
+			Where("memo_id = ? AND object_key = ?", memoID, key).
+			Count(&count).Error; err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+
+	err := db.Model(&mysql.Upload{}).
+		Where("user_id = ? AND purpose = ? AND status = ? AND object_key = ?", userID, mysql.UploadPurposeMemo, mysql.UploadStatusCompleted, key).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// replaceMemoCover 대표 이미지 교체 (기존 대표 이미지 행은 삭제하고 같은 순서에 새 이미지 추가)
+func replaceMemoCover(tx *gorm.DB, memoID uint, image *mysql.MemoImage) error {
+	image.MemoID = memoID
+	image.SortOrder = 0
+
+	var cover mysql.MemoImage
+	err := orderMemoImages(tx).Where("memo_id = ?", memoID).First(&cover).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil {
+		if err := tx.Delete(&cover).Error; err != nil {
+			return err
+		}
+		image.SortOrder = cover.SortOrder
+	}
+
+	return tx.Create(image).Error
+}
+
+// SyncMemoCover memos.image_key를 첫 번째 이미지 키로 갱신 (이미지가 없으면 빈 값, 외부 이미지 URL은 제거)
+// 이미지 변경 후 항상 호출되므로 메모 버전도 여기서 증가
+func SyncMemoCover(tx *gorm.DB, memoID uint) error {
+	imageKey := ""
+
+	var cover mysql.MemoImage
+	err := orderMemoImages(tx).Where("memo_id = ?", memoID).First(&cover).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil {
+		imageKey = cover.ObjectKey
+	}
+
+	return tx.Model(&mysql.Memo{}).Where("id = ?", memoID).Updates(map[string]interface{}{
+		"image_key": imageKey,
+		"image_url": "",
+		"version":   gorm.Expr("version + 1"),
+	}).Error
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/memo/model/interface"
+
+	"gorm.io/gorm"
+)
+
+type MemoImageRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewMemoImageRepository(gormDB *gorm.DB) _interface.IMemoImageRepository {
+	return &MemoImageRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetByID 특정 메모 조회 (권한 확인 및 변경 후 조회용, 태그 및 이미지 포함)
+func (r *MemoImageRepository) GetByID(ctx context.Context, id uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Where("id = ?", id).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *MemoImageRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// ReorderImages 전달된 ID 순서대로 정렬 순서를 다시 매기고 대표 이미지 갱신
+func (r *MemoImageRepository) ReorderImages(ctx context.Context, memoID uint, imageIDs []uint) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, imageID := range imageIDs {
+			if err := tx.Model(&mysql.MemoImage{}).
+				Where("id = ? AND memo_id = ?", imageID, memoID).
+				Update("sort_order", i).Error; err != nil {
+				return err
+			}
+		}
+
+		return SyncMemoCover(tx, memoID)
+	})
+}
+
+// DeleteImage 메모 이미지 삭제 후 대표 이미지 갱신
+func (r *MemoImageRepository) DeleteImage(ctx context.Context, memoID uint, imageID uint) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND memo_id = ?", imageID, memoID).Delete(&mysql.MemoImage{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return SyncMemoCover(tx, memoID)
+	})
+}
@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MemoRevisionRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewMemoRevisionRepository(gormDB *gorm.DB) _interface.IMemoRevisionRepository {
+	return &MemoRevisionRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetByID 특정 메모 조회 (권한 확인 및 롤백 전후 비교용, 태그 및 이미지 포함)
+func (r *MemoRevisionRepository) GetByID(ctx context.Context, id uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Where("id = ?", id).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *MemoRevisionRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetRevisions 메모 수정 이력 목록 조회 (최근 수정순, 수정한 사용자 포함)
+func (r *MemoRevisionRepository) GetRevisions(ctx context.Context, memoID uint, query request.ReqGetRevisionList) ([]mysql.MemoRevision, int64, error) {
+	var total int64
+	if err := r.GormDB.WithContext(ctx).Model(&mysql.MemoRevision{}).Where("memo_id = ?", memoID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var revisions []mysql.MemoRevision
+	result := r.GormDB.WithContext(ctx).
+		Preload("Editor").
+		Where("memo_id = ?", memoID).
+		Order("created_at DESC, id DESC").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&revisions)
+
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return revisions, total, nil
+}
+
+// GetRevision 메모의 특정 수정 이력 조회 (다른 메모의 이력이면 ErrRecordNotFound)
+func (r *MemoRevisionRepository) GetRevision(ctx context.Context, memoID uint, revisionID uint) (*mysql.MemoRevision, error) {
+	var revision mysql.MemoRevision
+	result := r.GormDB.WithContext(ctx).
+		Preload("Editor").
+		Where("id = ? AND memo_id = ?", revisionID, memoID).
+		First(&revision)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &revision, nil
+}
+
+// Rollback 메모 컬럼과 태그를 이력의 값으로 되돌림 (현재 버전이 version과 같을 때만, 다르면 ErrRecordNotFound)
+// 롤백도 하나의 수정으로 같은 트랜잭션에서 수정 이력을 저장
+func (r *MemoRevisionRepository) Rollback(ctx context.Context, id uint, version uint, updates map[string]interface{}, tagNames []string, replaceTags bool, revision func(before, after *mysql.Memo) *mysql.MemoRevision) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return withMemoRevision(tx, id, revision, func() error {
+			return patchMemoColumns(tx, id, version, updates, tagNames, replaceTags)
+		})
+	})
+}
+
+// withMemoRevision 트랜잭션 안에서 메모를 변경하고 변경 전후 메모로 만든 수정 이력을 저장 (이력 저장에 실패하면 변경도 롤백)
+// 변경 전 메모는 행 잠금으로 조회하므로 동시 수정이 있어도 이력의 수정 전 상태가 실제 상태와 같음
+// revision이 nil을 반환하면 (변경된 필드 없음) 이력을 저장하지 않음
+func withMemoRevision(tx *gorm.DB, id uint, revision func(before, after *mysql.Memo) *mysql.MemoRevision, change func() error) error {
+	if revision == nil {
+		return change()
+	}
+
+	before, err := getRevisionMemo(tx.Clauses(clause.Locking{Strength: "UPDATE"}), id)
+	if err != nil {
+		return err
+	}
+
+	if err := change(); err != nil {
+		return err
+	}
+
+	after, err := getRevisionMemo(tx, id)
+	if err != nil {
+		return err
+	}
+
+	if rev := revision(before, after); rev != nil {
+		return tx.Create(rev).Error
+	}
+	return nil
+}
+
+// getRevisionMemo 수정 이력 스냅샷용 메모 조회 (태그 포함)
+func getRevisionMemo(tx *gorm.DB, id uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := tx.Preload("Tags").
+		Where("id = ?", id).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"main/common/db/mysql"
+	"main/features/memo/model/request"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// findOrCreateTags 방의 태그를 이름으로 조회하고 없는 태그는 생성
+func findOrCreateTags(tx *gorm.DB, roomID uint, names []string) ([]mysql.Tag, error) {
+	if len(names) == 0 {
+		return []mysql.Tag{}, nil
+	}
+
+	newTags := make([]mysql.Tag, len(names))
+	for i, name := range names {
+		newTags[i] = mysql.Tag{RoomID: roomID, Name: name}
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&newTags).Error; err != nil {
+		return nil, err
+	}
+
+	var tags []mysql.Tag
+	if err := tx.Where("room_id = ? AND name IN ?", roomID, names).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// replaceMemoTags 메모의 태그를 주어진 이름 목록으로 교체
+func replaceMemoTags(tx *gorm.DB, memo *mysql.Memo, names []string) error {
+	tags, err := findOrCreateTags(tx, memo.RoomID, names)
+	if err != nil {
+		return err
+	}
+	return tx.Model(memo).Association("Tags").Replace(tags)
+}
+
+// applyTagFilter 태그 필터 적용 (any: 하나라도 포함, all: 모두 포함)
+func applyTagFilter(db *gorm.DB, tags []string, match string) *gorm.DB {
+	if len(tags) == 0 {
+		return db
+	}
+
+	if match == request.TagMatchAll {
+		return db.Where("memos.id IN (SELECT mt.memo_id FROM memo_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name IN ? GROUP BY mt.memo_id HAVING COUNT(DISTINCT t.name) = ?)", tags, len(tags))
+	}
+
+	return db.Where("memos.id IN (SELECT mt.memo_id FROM memo_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name IN ?)", tags)
+}
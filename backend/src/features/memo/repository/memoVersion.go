@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"main/common/db/mysql"
+
+	"gorm.io/gorm"
+)
+
+// whereMemoVersion version이 0이 아니면 현재 버전이 같은 메모만 대상으로 함 (If-Match 조건부 변경)
+func whereMemoVersion(db *gorm.DB, version uint) *gorm.DB {
+	if version == 0 {
+		return db
+	}
+	return db.Where("version = ?", version)
+}
+
+// bumpMemoVersion 메모 버전 1 증가 (version이 0이 아니면 현재 버전이 같을 때만, 다르거나 메모가 없으면 ErrRecordNotFound)
+// 같은 트랜잭션의 이후 변경이 끝날 때까지 행 잠금이 유지되므로 동시 수정 중 하나만 성공함
+func bumpMemoVersion(tx *gorm.DB, id uint, version uint) error {
+	result := whereMemoVersion(tx.Model(&mysql.Memo{}).Where("id = ?", id), version).
+		UpdateColumn("version", gorm.Expr("version + 1"))
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
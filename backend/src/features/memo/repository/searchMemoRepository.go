@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+
+	"gorm.io/gorm"
+)
+
+// memoMatchExpr FULLTEXT 인덱스(ft_memos_search)와 동일한 컬럼 구성이어야 함
+const memoMatchExpr = "MATCH(memos.title, memos.content, memos.location_name, memos.business_name, memos.business_address) AGAINST(? IN NATURAL LANGUAGE MODE)"
+
+type SearchMemoRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewSearchMemoRepository(gormDB *gorm.DB) _interface.ISearchMemoRepository {
+	return &SearchMemoRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *SearchMemoRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// Search 사용자가 멤버인 방들의 메모 전문 검색 (관련도 순)
+func (r *SearchMemoRepository) Search(ctx context.Context, userID uint, req request.ReqSearchMemo) ([]mysql.Memo, error) {
+	var memos []mysql.Memo
+	result := applySearchFilter(r.GormDB.WithContext(ctx).Model(&mysql.Memo{}), userID, req).
+		Select("memos.*, "+memoMatchExpr+" AS relevance", req.Query).
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Order("relevance DESC").
+		Order("memos.id DESC").
+		Limit(req.Limit).
+		Offset(req.Offset).
+		Find(&memos)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return memos, nil
+}
+
+// CountSearch 검색 결과 전체 개수 조회
+func (r *SearchMemoRepository) CountSearch(ctx context.Context, userID uint, req request.ReqSearchMemo) (int64, error) {
+	var total int64
+	result := applySearchFilter(r.GormDB.WithContext(ctx).Model(&mysql.Memo{}), userID, req).Count(&total)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return total, nil
+}
+
+// applySearchFilter 검색어 매칭 + 사용자가 멤버인 방 + Room ID / 위시리스트 / 태그 필터 적용
+func applySearchFilter(db *gorm.DB, userID uint, req request.ReqSearchMemo) *gorm.DB {
+	db = db.Where(memoMatchExpr, req.Query).
+		Where("memos.room_id IN (SELECT room_id FROM room_members WHERE user_id = ?)", userID)
+
+	if req.RoomID != nil {
+		db = db.Where("memos.room_id = ?", *req.RoomID)
+	}
+
+	if req.IsWishlist != nil {
+		db = db.Where("memos.is_wishlist = ?", *req.IsWishlist)
+	}
+
+	return applyTagFilter(db, req.Tags, req.TagMatch)
+}
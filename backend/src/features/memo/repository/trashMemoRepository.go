@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TrashMemoRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewTrashMemoRepository(gormDB *gorm.DB) _interface.ITrashMemoRepository {
+	return &TrashMemoRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetTrashList 휴지통 메모 목록 조회 (사용자가 작성했거나 소유한 방의 메모, 최근 삭제순)
+func (r *TrashMemoRepository) GetTrashList(ctx context.Context, userID uint, query request.ReqGetTrashList) ([]mysql.Memo, int64, error) {
+	var total int64
+	if err := applyTrashFilter(r.GormDB.WithContext(ctx).Unscoped().Model(&mysql.Memo{}), userID, query).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var memos []mysql.Memo
+	result := applyTrashFilter(r.GormDB.WithContext(ctx).Unscoped(), userID, query).
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Order("memos.deleted_at DESC, memos.id DESC").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&memos)
+
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return memos, total, nil
+}
+
+// applyTrashFilter 휴지통 메모 조건 (복원 권한이 있는 메모, 즉 작성자이거나 방 소유자인 메모)
+func applyTrashFilter(db *gorm.DB, userID uint, query request.ReqGetTrashList) *gorm.DB {
+	db = db.Where("memos.deleted_at IS NOT NULL").
+		Where("memos.user_id = ? OR memos.room_id IN (SELECT room_id FROM room_members WHERE user_id = ? AND role = ?)", userID, userID, mysql.RoomRoleOwner)
+
+	if query.RoomID != nil {
+		db = db.Where("memos.room_id = ?", *query.RoomID)
+	}
+	return db
+}
+
+// GetTrashedByID 휴지통에 있는 메모 조회 (삭제되지 않은 메모는 ErrRecordNotFound)
+func (r *TrashMemoRepository) GetTrashedByID(ctx context.Context, id uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Unscoped().
+		Preload("Images", orderMemoImages).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
+
+// GetByID 복원된 메모 조회 (태그 및 이미지 포함)
+func (r *TrashMemoRepository) GetByID(ctx context.Context, id uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Where("id = ?", id).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *TrashMemoRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// Restore 휴지통 메모 복원 (메모와 함께 숨겨진 댓글, 즉 deleted_with_memo로 표시된 댓글도 복원)
+// 삭제 전 ETag로 조건부 요청하지 않도록 버전도 증가
+func (r *TrashMemoRepository) Restore(ctx context.Context, id uint) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Model(&mysql.Memo{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			UpdateColumns(map[string]interface{}{
+				"deleted_at": nil,
+				"version":    gorm.Expr("version + 1"),
+			})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Unscoped().
+			Model(&mysql.Comment{}).
+			Where("memo_id = ? AND deleted_with_memo = ?", id, true).
+			UpdateColumns(map[string]interface{}{
+				"deleted_at":        nil,
+				"deleted_with_memo": false,
+			}).Error
+	})
+}
+
+// GetExpiredTrash deletedBefore 이전에 휴지통으로 옮긴 메모 조회 (영구 삭제 대상, 이미지 포함)
+func (r *TrashMemoRepository) GetExpiredTrash(ctx context.Context, deletedBefore time.Time, limit int) ([]mysql.Memo, error) {
+	var memos []mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Unscoped().
+		Preload("Images").
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Order("deleted_at ASC").
+		Limit(limit).
+		Find(&memos)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return memos, nil
+}
+
+// HardDelete 휴지통의 메모를 댓글, 태그 연결, 이미지 행, 수정 이력과 함께 영구 삭제 (저장소 파일은 UseCase에서 삭제)
+// 조회 이후 복원되거나 version(0이 아니면)이 다른 메모는 건너뛰고 실제로 삭제한 메모 ID를 반환 (하나도 없으면 ErrRecordNotFound)
+func (r *TrashMemoRepository) HardDelete(ctx context.Context, ids []uint, version uint) ([]uint, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var trashedIDs []uint
+	err := r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 복원과 동시에 실행되지 않도록 휴지통의 메모 행을 잠금
+		if err := whereMemoVersion(tx.Unscoped().Model(&mysql.Memo{}), version).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND deleted_at IS NOT NULL", ids).
+			Pluck("id", &trashedIDs).Error; err != nil {
+			return err
+		}
+
+		if len(trashedIDs) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Unscoped().Where("memo_id IN ?", trashedIDs).Delete(&mysql.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("memo_id IN ?", trashedIDs).Delete(&mysql.MemoTag{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("memo_id IN ?", trashedIDs).Delete(&mysql.MemoImage{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("memo_id IN ?", trashedIDs).Delete(&mysql.MemoRevision{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Where("id IN ?", trashedIDs).Delete(&mysql.Memo{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trashedIDs, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"main/common/db/mysql"
 	_interface "main/features/memo/model/interface"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -18,12 +19,83 @@ func NewUpdateMemoRepository(gormDB *gorm.DB) _interface.IUpdateMemoRepository {
 	}
 }
 
-// Update 메모 수정
-func (r *UpdateMemoRepository) Update(ctx context.Context, id uint, userID uint, memo *mysql.Memo) error {
-	result := r.GormDB.WithContext(ctx).
-		Model(&mysql.Memo{}).
-		Where("id = ? AND user_id = ?", id, userID).
-		Updates(memo)
+// Update 메모 수정 (tagNames가 nil이 아니면 태그도 교체, 빈 배열이면 모든 태그 제거)
+// cover가 있으면 대표 이미지를 교체하고 images는 기존 이미지 뒤에 추가
+// version이 0이 아니면 현재 버전이 같을 때만 수정 (다르면 ErrRecordNotFound)
+// 수정 이력도 같은 트랜잭션에서 저장 (저장에 실패하면 수정도 롤백)
+func (r *UpdateMemoRepository) Update(ctx context.Context, id uint, version uint, memo *mysql.Memo, tagNames []string, cover *mysql.MemoImage, images []mysql.MemoImage, revision func(before, after *mysql.Memo) *mysql.MemoRevision) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return withMemoRevision(tx, id, revision, func() error {
+			return updateMemo(tx, id, version, memo, tagNames, cover, images)
+		})
+	})
+}
+
+// updateMemo 메모 수정 (Update 트랜잭션 안에서 실행)
+func updateMemo(tx *gorm.DB, id uint, version uint, memo *mysql.Memo, tagNames []string, cover *mysql.MemoImage, images []mysql.MemoImage) error {
+	if err := bumpMemoVersion(tx, id, version); err != nil {
+		return err
+	}
+
+	// 버전 증가로 메모가 있는 것은 확인했으므로 변경된 값이 없어도 에러로 보지 않음
+	if err := tx.Model(&mysql.Memo{}).Where("id = ?", id).Updates(memo).Error; err != nil {
+		return err
+	}
+
+	// 외부 이미지 URL로 바꾸면 기존 대표 이미지 키는 사용하지 않음
+	if memo.ImageURL != "" && memo.ImageKey == "" {
+		if err := tx.Model(&mysql.Memo{}).Where("id = ?", id).Update("image_key", "").Error; err != nil {
+			return err
+		}
+	}
+
+	// 이미지 변경 후 대표 이미지 키 동기화
+	if cover != nil || len(images) > 0 {
+		if cover != nil {
+			if err := replaceMemoCover(tx, id, cover); err != nil {
+				return err
+			}
+		}
+		if err := AppendMemoImages(tx, id, images); err != nil {
+			return err
+		}
+		if err := SyncMemoCover(tx, id); err != nil {
+			return err
+		}
+	}
+
+	if tagNames == nil {
+		return nil
+	}
+
+	// 방 이동 시 이동한 방의 태그로 연결
+	var updated mysql.Memo
+	if err := tx.Select("id", "room_id").Where("id = ?", id).First(&updated).Error; err != nil {
+		return err
+	}
+	return replaceMemoTags(tx, &updated, tagNames)
+}
+
+// Patch 메모 부분 수정 (updates의 컬럼만 변경하므로 false, 0, NULL도 저장됨)
+// replaceTags가 true면 tagNames로 태그 교체 (빈 배열이면 모든 태그 제거)
+// version이 0이 아니면 현재 버전이 같을 때만 수정 (다르면 ErrRecordNotFound)
+// 수정 이력도 같은 트랜잭션에서 저장 (저장에 실패하면 수정도 롤백)
+func (r *UpdateMemoRepository) Patch(ctx context.Context, id uint, version uint, updates map[string]interface{}, tagNames []string, replaceTags bool, revision func(before, after *mysql.Memo) *mysql.MemoRevision) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return withMemoRevision(tx, id, revision, func() error {
+			return patchMemoColumns(tx, id, version, updates, tagNames, replaceTags)
+		})
+	})
+}
+
+// patchMemoColumns 메모 컬럼 일부 변경 후 필요하면 태그 교체 (PATCH, 수정 이력 롤백에서 사용)
+// version이 0이 아니면 현재 버전이 같을 때만 변경 (다르면 ErrRecordNotFound)
+func patchMemoColumns(tx *gorm.DB, id uint, version uint, updates map[string]interface{}, tagNames []string, replaceTags bool) error {
+	// 변경할 컬럼이 없어도 updated_at과 버전은 갱신
+	updates["updated_at"] = time.Now()
+	updates["version"] = gorm.Expr("version + 1")
+	result := whereMemoVersion(tx.Model(&mysql.Memo{}).Where("id = ?", id), version).
+		Updates(updates)
 
 	if result.Error != nil {
 		return result.Error
@@ -33,14 +105,29 @@ func (r *UpdateMemoRepository) Update(ctx context.Context, id uint, userID uint,
 		return gorm.ErrRecordNotFound
 	}
 
-	return nil
+	if !replaceTags {
+		return nil
+	}
+
+	var updated mysql.Memo
+	if err := tx.Select("id", "room_id").Where("id = ?", id).First(&updated).Error; err != nil {
+		return err
+	}
+	return replaceMemoTags(tx, &updated, tagNames)
+}
+
+// IsIssuedImageKey key가 메모의 이미지이거나 사용자가 완료 처리한 메모 업로드의 객체 키인지 확인 (image_url로 받은 대표 이미지 검증용)
+func (r *UpdateMemoRepository) IsIssuedImageKey(ctx context.Context, userID uint, memoID uint, key string) (bool, error) {
+	return isIssuedImageKey(r.GormDB.WithContext(ctx), userID, memoID, key)
 }
 
-// GetByID 특정 메모 조회 (업데이트 후 조회용)
-func (r *UpdateMemoRepository) GetByID(ctx context.Context, id uint, userID uint) (*mysql.Memo, error) {
+// GetByID 특정 메모 조회 (권한 확인 및 수정 후 조회용, 태그 및 이미지 포함)
+func (r *UpdateMemoRepository) GetByID(ctx context.Context, id uint) (*mysql.Memo, error) {
 	var memo mysql.Memo
 	result := r.GormDB.WithContext(ctx).
-		Where("id = ? AND user_id = ?", id, userID).
+		Preload("Tags").
+		Preload("Images", orderMemoImages).
+		Where("id = ?", id).
 		First(&memo)
 
 	if result.Error != nil {
@@ -49,3 +136,17 @@ func (r *UpdateMemoRepository) GetByID(ctx context.Context, id uint, userID uint
 
 	return &memo, nil
 }
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *UpdateMemoRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
@@ -2,7 +2,7 @@ package usecase
 
 import (
 	"context"
-	"fmt"
+	"main/common"
 	"main/common/db/mysql"
 	"main/common/storage"
 	_interface "main/features/memo/model/interface"
@@ -13,63 +13,123 @@ import (
 
 type CreateMemoUseCase struct {
 	Repository     _interface.ICreateMemoRepository
+	Storage        storage.ObjectStorage
 	ContextTimeout time.Duration
 }
 
-func NewCreateMemoUseCase(repo _interface.ICreateMemoRepository, timeout time.Duration) _interface.ICreateMemoUseCase {
+func NewCreateMemoUseCase(repo _interface.ICreateMemoRepository, store storage.ObjectStorage, timeout time.Duration) _interface.ICreateMemoUseCase {
 	return &CreateMemoUseCase{
 		Repository:     repo,
+		Storage:        store,
 		ContextTimeout: timeout,
 	}
 }
 
-// CreateMemo 메모 생성
+// CreateMemo 메모 생성 (방의 owner, editor만 가능)
 func (uc *CreateMemoUseCase) CreateMemo(ctx context.Context, userID uint, req request.ReqCreateMemo) (*response.ResMemo, error) {
 	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
 	defer cancel()
 
 	// 비즈니스 정보 필드 검증
 	if err := request.ValidateBusinessFields(req.BusinessName, req.BusinessPhone, req.BusinessAddress); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	// 태그 검증
+	tagNames, err := request.NormalizeTags(req.Tags)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	// room_id가 없으면 사용자의 기본 방에 작성
+	roomID := req.RoomID
+	if roomID == nil {
+		defaultRoomID, err := uc.Repository.GetDefaultRoomID(ctx, userID)
+		if err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+		if defaultRoomID == nil {
+			return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "room_id is required (user has no default room)", common.ErrFromClient)
+		}
+		roomID = defaultRoomID
+	}
+
+	// 메모를 작성할 방의 권한 확인
+	member, err := uc.Repository.GetRoomMember(ctx, *roomID, userID)
+	if err != nil {
+		return nil, roomMemberDBError(ctx, err)
+	}
+
+	if err := checkEditPermission(ctx, member); err != nil {
 		return nil, err
 	}
 
-	// 이미지 파일이 있으면 S3에 업로드
-	imageURL := req.ImageURL
+	// 이미지 업로드 (image 파일이 대표 이미지, images[]는 그 뒤에 추가)
+	uploads := req.Images
 	if req.ImageFile != nil && req.ImageHeader != nil {
-		if storage.S3 == nil {
-			return nil, fmt.Errorf("S3 storage is not configured")
-		}
+		uploads = append([]request.ReqMemoImage{{File: req.ImageFile, Header: req.ImageHeader}}, req.Images...)
+	}
+	if err := validateMemoImages(ctx, uploads, 0); err != nil {
+		return nil, err
+	}
 
-		uploadedURL, err := storage.S3.UploadFile(ctx, req.ImageFile, req.ImageHeader, "image/daily")
-		if err != nil {
-			return nil, fmt.Errorf("failed to upload image to S3: %w", err)
+	images, metadata, err := uploadMemoImages(ctx, uc.Storage, uploads)
+	if err != nil {
+		return nil, err
+	}
+	for i := range images {
+		images[i].SortOrder = i
+	}
+
+	// 업로드한 이미지가 있으면 첫 번째 이미지가 대표 이미지, 없으면 요청의 image_url 사용
+	imageKey, imageURL, err := memoImageRef(ctx, uc.Storage, req.ImageURL, func(key string) (bool, error) {
+		return uc.Repository.IsIssuedImageKey(ctx, userID, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(images) > 0 {
+		imageKey, imageURL = images[0].ObjectKey, ""
+	}
+
+	// 요청 시 비어 있는 위치와 방문 일시를 사진 EXIF로 채움 (직접 입력한 값이 우선)
+	latitude, longitude, visitedAt := req.Latitude, req.Longitude, req.VisitedAt
+	if req.UsePhotoMetadata {
+		if latitude == nil && longitude == nil && metadata.Latitude != nil {
+			latitude, longitude = metadata.Latitude, metadata.Longitude
+		}
+		if visitedAt == nil {
+			visitedAt = metadata.TakenAt
 		}
-		imageURL = uploadedURL
 	}
 
 	memo := &mysql.Memo{
 		UserID:          userID,
-		RoomID:          req.RoomID,
+		RoomID:          *roomID,
 		Title:           req.Title,
 		Content:         req.Content,
 		ImageURL:        imageURL,
+		ImageKey:        imageKey,
 		Rating:          req.Rating,
 		IsPinned:        req.IsPinned,
-		Latitude:        req.Latitude,
-		Longitude:       req.Longitude,
+		Latitude:        latitude,
+		Longitude:       longitude,
+		Geohash:         memoGeohash(latitude, longitude),
 		LocationName:    req.LocationName,
 		Category:        req.Category,
+		VisitedAt:       visitedAt,
 		IsWishlist:      req.IsWishlist,
 		BusinessName:    req.BusinessName,
 		BusinessPhone:   req.BusinessPhone,
 		BusinessAddress: req.BusinessAddress,
 		NaverPlaceURL:   req.NaverPlaceURL,
+		Version:         1,
+		Images:          images,
 	}
 
-	err := uc.Repository.Create(ctx, memo)
-	if err != nil {
-		return nil, err
+	if err := uc.Repository.Create(ctx, memo, tagNames); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
 	}
 
-	return convertMemoToResponse(memo), nil
+	return convertMemoToResponse(memo, uc.Storage), nil
 }
@@ -2,26 +2,53 @@ package usecase
 
 import (
 	"context"
+	"main/common/storage"
 	_interface "main/features/memo/model/interface"
 	"time"
 )
 
 type DeleteMemoUseCase struct {
 	Repository     _interface.IDeleteMemoRepository
+	Storage        storage.ObjectStorage
 	ContextTimeout time.Duration
 }
 
-func NewDeleteMemoUseCase(repo _interface.IDeleteMemoRepository, timeout time.Duration) _interface.IDeleteMemoUseCase {
+func NewDeleteMemoUseCase(repo _interface.IDeleteMemoRepository, store storage.ObjectStorage, timeout time.Duration) _interface.IDeleteMemoUseCase {
 	return &DeleteMemoUseCase{
 		Repository:     repo,
+		Storage:        store,
 		ContextTimeout: timeout,
 	}
 }
 
-// DeleteMemo 메모 삭제
-func (uc *DeleteMemoUseCase) DeleteMemo(ctx context.Context, memoID uint, userID uint) error {
+// DeleteMemo 메모를 휴지통으로 이동 (작성자 또는 방 소유자만 가능)
+// version은 If-Match로 받은 메모 버전으로 현재 버전과 다르면 삭제하지 않음 (0이면 확인하지 않음)
+func (uc *DeleteMemoUseCase) DeleteMemo(ctx context.Context, memoID uint, userID uint, version uint) error {
 	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
 	defer cancel()
 
-	return uc.Repository.Delete(ctx, memoID, userID)
+	memo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return memoDBError(ctx, err)
+	}
+
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	if err := checkDeletePermission(ctx, memo, member); err != nil {
+		return err
+	}
+
+	// If-Match 버전 확인 (다른 요청이 먼저 수정했으면 현재 메모와 함께 412)
+	if err := checkMemoVersion(ctx, memo, version, uc.Storage); err != nil {
+		return err
+	}
+
+	if err := uc.Repository.Delete(ctx, memoID, version); err != nil {
+		return memoVersionDBError(ctx, err, uc.Repository.GetByID, memoID, uc.Storage)
+	}
+
+	return nil
 }
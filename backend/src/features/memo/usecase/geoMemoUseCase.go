@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"main/common"
+	"main/common/storage"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"main/features/memo/model/response"
+	"time"
+)
+
+type GeoMemoUseCase struct {
+	Repository     _interface.IGeoMemoRepository
+	Storage        storage.ObjectStorage
+	ContextTimeout time.Duration
+}
+
+func NewGeoMemoUseCase(repo _interface.IGeoMemoRepository, store storage.ObjectStorage, timeout time.Duration) _interface.IGeoMemoUseCase {
+	return &GeoMemoUseCase{
+		Repository:     repo,
+		Storage:        store,
+		ContextTimeout: timeout,
+	}
+}
+
+// GetNearbyMemo 중심 좌표 반경 안의 메모를 가까운 순으로 조회
+func (uc *GeoMemoUseCase) GetNearbyMemo(ctx context.Context, userID uint, req request.ReqNearbyMemo) (*response.ResMemoList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if !isValidCoordinate(req.Latitude, req.Longitude) {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "lat/lng out of range", common.ErrFromClient)
+	}
+	if req.RadiusM <= 0 || req.RadiusM > request.MaxNearbyRadiusM {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "radius_m must be between 1 and 50000", common.ErrFromClient)
+	}
+
+	limit, err := geoMemoLimit(ctx, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	minLat, minLng, maxLat, maxLng := common.BoundingBoxAround(req.Latitude, req.Longitude, req.RadiusM)
+	radius := req.RadiusM
+	query := request.MemoGeoQuery{
+		RoomID:          req.RoomID,
+		IsWishlist:      req.IsWishlist,
+		Category:        req.Category,
+		MinLat:          minLat,
+		MinLng:          minLng,
+		MaxLat:          maxLat,
+		MaxLng:          maxLng,
+		CenterLat:       req.Latitude,
+		CenterLng:       req.Longitude,
+		RadiusM:         &radius,
+		GeohashPrefixes: common.GeohashCover(minLat, minLng, maxLat, maxLng),
+		Limit:           limit,
+	}
+
+	return uc.getInArea(ctx, userID, query)
+}
+
+// GetBBoxMemo 지도 화면 영역(남서~북동) 안의 메모를 영역 중심과 가까운 순으로 조회
+func (uc *GeoMemoUseCase) GetBBoxMemo(ctx context.Context, userID uint, req request.ReqBBoxMemo) (*response.ResMemoList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if !isValidCoordinate(req.SouthWestLat, req.SouthWestLng) || !isValidCoordinate(req.NorthEastLat, req.NorthEastLng) {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "sw/ne out of range", common.ErrFromClient)
+	}
+	if req.SouthWestLat > req.NorthEastLat || req.SouthWestLng > req.NorthEastLng {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "sw must be south-west of ne (antimeridian crossing is not supported)", common.ErrFromClient)
+	}
+
+	limit, err := geoMemoLimit(ctx, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query := request.MemoGeoQuery{
+		RoomID:          req.RoomID,
+		IsWishlist:      req.IsWishlist,
+		Category:        req.Category,
+		MinLat:          req.SouthWestLat,
+		MinLng:          req.SouthWestLng,
+		MaxLat:          req.NorthEastLat,
+		MaxLng:          req.NorthEastLng,
+		CenterLat:       (req.SouthWestLat + req.NorthEastLat) / 2,
+		CenterLng:       (req.SouthWestLng + req.NorthEastLng) / 2,
+		GeohashPrefixes: common.GeohashCover(req.SouthWestLat, req.SouthWestLng, req.NorthEastLat, req.NorthEastLng),
+		Limit:           limit,
+	}
+
+	return uc.getInArea(ctx, userID, query)
+}
+
+// GetMemoClusters 지도 영역의 메모를 줌 레벨에 맞는 geohash 셀 단위로 묶어서 조회 (높은 줌에서는 개별 메모)
+func (uc *GeoMemoUseCase) GetMemoClusters(ctx context.Context, userID uint, req request.ReqMemoClusters) (*response.ResMemoClusterList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if !isValidCoordinate(req.SouthWestLat, req.SouthWestLng) || !isValidCoordinate(req.NorthEastLat, req.NorthEastLng) {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "bbox out of range", common.ErrFromClient)
+	}
+	if req.SouthWestLat > req.NorthEastLat || req.SouthWestLng > req.NorthEastLng {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "bbox must be sw_lat,sw_lng,ne_lat,ne_lng (antimeridian crossing is not supported)", common.ErrFromClient)
+	}
+	if req.Zoom < 0 || req.Zoom > request.MaxMapZoom {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "zoom must be between 0 and 22", common.ErrFromClient)
+	}
+
+	query := request.MemoGeoQuery{
+		RoomID:          req.RoomID,
+		IsWishlist:      req.IsWishlist,
+		Category:        req.Category,
+		MinLat:          req.SouthWestLat,
+		MinLng:          req.SouthWestLng,
+		MaxLat:          req.NorthEastLat,
+		MaxLng:          req.NorthEastLng,
+		CenterLat:       (req.SouthWestLat + req.NorthEastLat) / 2,
+		CenterLng:       (req.SouthWestLng + req.NorthEastLng) / 2,
+		GeohashPrefixes: common.GeohashCover(req.SouthWestLat, req.SouthWestLng, req.NorthEastLat, req.NorthEastLng),
+		Limit:           request.MaxGeoMemoLimit,
+	}
+
+	// 높은 줌 레벨에서는 개별 메모 반환
+	if req.Zoom >= request.ClusterIndividualZoom {
+		memoList, err := uc.getInArea(ctx, userID, query)
+		if err != nil {
+			return nil, err
+		}
+		return &response.ResMemoClusterList{
+			Zoom:     req.Zoom,
+			Clusters: []response.ResMemoCluster{},
+			Memos:    memoList.Memos,
+			Total:    memoList.Total,
+		}, nil
+	}
+
+	// 특정 방을 지정한 경우 멤버인지 확인
+	if query.RoomID != nil {
+		if _, err := uc.Repository.GetRoomMember(ctx, *query.RoomID, userID); err != nil {
+			return nil, roomMemberDBError(ctx, err)
+		}
+	}
+
+	rows, err := uc.Repository.GetClusters(ctx, userID, query, clusterPrecision(req.Zoom))
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	clusters, total := buildMemoClusters(rows)
+
+	return &response.ResMemoClusterList{
+		Zoom:     req.Zoom,
+		Clusters: clusters,
+		Total:    total,
+	}, nil
+}
+
+// getInArea 방 권한 확인 후 영역 안의 메모 조회
+func (uc *GeoMemoUseCase) getInArea(ctx context.Context, userID uint, query request.MemoGeoQuery) (*response.ResMemoList, error) {
+	// 특정 방을 지정한 경우 멤버인지 확인
+	if query.RoomID != nil {
+		if _, err := uc.Repository.GetRoomMember(ctx, *query.RoomID, userID); err != nil {
+			return nil, roomMemberDBError(ctx, err)
+		}
+	}
+
+	total, err := uc.Repository.CountInArea(ctx, userID, query)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	memos, err := uc.Repository.GetInArea(ctx, userID, query)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	resMemos := make([]response.ResMemo, len(memos))
+	for i, memo := range memos {
+		resMemos[i] = *convertMemoToResponse(&memo, uc.Storage)
+	}
+
+	return &response.ResMemoList{
+		Memos:   resMemos,
+		Total:   total,
+		HasMore: total > int64(len(resMemos)),
+	}, nil
+}
+
+// geoMemoLimit 조회 개수 검증 (기본 100, 최대 500)
+func geoMemoLimit(ctx context.Context, limit int) (int, error) {
+	if limit == 0 {
+		return request.DefaultGeoMemoLimit, nil
+	}
+	if limit < 1 || limit > request.MaxGeoMemoLimit {
+		return 0, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "limit must be between 1 and 500", common.ErrFromClient)
+	}
+	return limit, nil
+}
+
+// clusterPrecision 줌 레벨별 클러스터 geohash 정밀도 (셀 크기가 화면에서 대략 60~120px 정도가 되도록)
+func clusterPrecision(zoom int) int {
+	switch {
+	case zoom <= 2:
+		return 1
+	case zoom <= 5:
+		return 2
+	case zoom <= 7:
+		return 3
+	case zoom <= 10:
+		return 4
+	case zoom <= 12:
+		return 5
+	case zoom <= 14:
+		return 6
+	default:
+		return 7
+	}
+}
+
+// buildMemoClusters 셀+카테고리 단위 집계 결과를 셀 단위 클러스터로 합침
+func buildMemoClusters(rows []request.MemoClusterRow) ([]response.ResMemoCluster, int64) {
+	type clusterAcc struct {
+		row           request.MemoClusterRow
+		categoryCount map[string]int64
+	}
+
+	order := make([]string, 0)
+	accs := make(map[string]*clusterAcc)
+	for _, row := range rows {
+		acc, ok := accs[row.Cell]
+		if !ok {
+			acc = &clusterAcc{
+				row:           request.MemoClusterRow{Cell: row.Cell, MinMemoID: row.MinMemoID},
+				categoryCount: make(map[string]int64),
+			}
+			accs[row.Cell] = acc
+			order = append(order, row.Cell)
+		}
+		acc.row.Count += row.Count
+		acc.row.SumLat += row.SumLat
+		acc.row.SumLng += row.SumLng
+		acc.row.SumRating += row.SumRating
+		acc.row.RatedCount += row.RatedCount
+		if row.MinMemoID < acc.row.MinMemoID {
+			acc.row.MinMemoID = row.MinMemoID
+		}
+		if row.Category != nil && *row.Category != "" {
+			acc.categoryCount[*row.Category] += row.Count
+		}
+	}
+
+	var total int64
+	clusters := make([]response.ResMemoCluster, 0, len(order))
+	for _, cell := range order {
+		acc := accs[cell]
+		total += acc.row.Count
+
+		cluster := response.ResMemoCluster{
+			Geohash:   cell,
+			Count:     acc.row.Count,
+			Latitude:  acc.row.SumLat / float64(acc.row.Count),
+			Longitude: acc.row.SumLng / float64(acc.row.Count),
+		}
+
+		// 가장 많은 카테고리 (동률이면 이름순)
+		var dominant string
+		var dominantCount int64
+		for category, count := range acc.categoryCount {
+			if count > dominantCount || (count == dominantCount && category < dominant) {
+				dominant, dominantCount = category, count
+			}
+		}
+		if dominantCount > 0 {
+			cluster.DominantCategory = &dominant
+		}
+
+		if acc.row.RatedCount > 0 {
+			averageRating := acc.row.SumRating / float64(acc.row.RatedCount)
+			cluster.AverageRating = &averageRating
+		}
+
+		if acc.row.Count == 1 {
+			memoID := acc.row.MinMemoID
+			cluster.MemoID = &memoID
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, total
+}
@@ -2,19 +2,25 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"main/common"
+	"main/common/storage"
 	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
 	"main/features/memo/model/response"
 	"time"
 )
 
 type GetMemoUseCase struct {
 	Repository     _interface.IGetMemoRepository
+	Storage        storage.ObjectStorage
 	ContextTimeout time.Duration
 }
 
-func NewGetMemoUseCase(repo _interface.IGetMemoRepository, timeout time.Duration) _interface.IGetMemoUseCase {
+func NewGetMemoUseCase(repo _interface.IGetMemoRepository, store storage.ObjectStorage, timeout time.Duration) _interface.IGetMemoUseCase {
 	return &GetMemoUseCase{
 		Repository:     repo,
+		Storage:        store,
 		ContextTimeout: timeout,
 	}
 }
@@ -24,31 +30,129 @@ func (uc *GetMemoUseCase) GetMemo(ctx context.Context, memoID uint, userID uint)
 	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
 	defer cancel()
 
-	memo, err := uc.Repository.GetByID(ctx, memoID, userID)
+	memo, err := uc.Repository.GetByID(ctx, memoID)
 	if err != nil {
-		return nil, err
+		return nil, memoDBError(ctx, err)
 	}
 
-	return convertMemoToResponse(memo), nil
+	// 방 멤버(viewer 이상)만 조회 가능
+	if _, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID); err != nil {
+		return nil, roomMemberDBError(ctx, err)
+	}
+
+	return convertMemoToResponse(memo, uc.Storage), nil
 }
 
-// GetMemoList 메모 목록 조회 (Room ID 및 위시리스트 필터 옵션 포함)
-func (uc *GetMemoUseCase) GetMemoList(ctx context.Context, userID uint, roomID *uint, isWishlist *bool) (*response.ResMemoList, error) {
+// GetMemoList 사용자가 속한 방들의 메모 목록 조회 (필터, 정렬, 커서 페이지네이션)
+func (uc *GetMemoUseCase) GetMemoList(ctx context.Context, userID uint, req request.ReqGetMemoList) (*response.ResMemoList, error) {
 	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
 	defer cancel()
 
-	memos, err := uc.Repository.GetListByUserID(ctx, userID, roomID, isWishlist)
+	query, err := buildMemoListQuery(req)
 	if err != nil {
-		return nil, err
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	// 특정 방을 지정한 경우 멤버인지 확인
+	if query.RoomID != nil {
+		if _, err := uc.Repository.GetRoomMember(ctx, *query.RoomID, userID); err != nil {
+			return nil, roomMemberDBError(ctx, err)
+		}
+	}
+
+	total, err := uc.Repository.CountByUserID(ctx, userID, query)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	// 다음 페이지 존재 여부 확인을 위해 1개 더 조회
+	limit := query.Limit
+	query.Limit = limit + 1
+	memos, err := uc.Repository.GetListByUserID(ctx, userID, query)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	hasMore := len(memos) > limit
+	if hasMore {
+		memos = memos[:limit]
 	}
 
 	resMemos := make([]response.ResMemo, len(memos))
 	for i, memo := range memos {
-		resMemos[i] = *convertMemoToResponse(&memo)
+		resMemos[i] = *convertMemoToResponse(&memo, uc.Storage)
+	}
+
+	nextCursor := ""
+	if hasMore {
+		nextCursor = encodeMemoCursor(query, &memos[len(memos)-1])
 	}
 
 	return &response.ResMemoList{
-		Memos: resMemos,
-		Total: int64(len(resMemos)),
+		Memos:      resMemos,
+		Total:      total,
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
 	}, nil
 }
+
+// buildMemoListQuery 요청 파라미터 검증 및 Repository 조회 조건 생성
+func buildMemoListQuery(req request.ReqGetMemoList) (request.MemoListQuery, error) {
+	query := request.MemoListQuery{
+		RoomID:      req.RoomID,
+		IsWishlist:  req.IsWishlist,
+		Category:    req.Category,
+		TagMatch:    req.TagMatch,
+		Limit:       req.Limit,
+		Sort:        req.Sort,
+		PinnedFirst: req.PinnedFirst,
+	}
+
+	if query.Limit == 0 {
+		query.Limit = request.DefaultMemoListLimit
+	}
+	if query.Limit < 1 || query.Limit > request.MaxMemoListLimit {
+		return query, fmt.Errorf("limit must be between 1 and %d", request.MaxMemoListLimit)
+	}
+
+	tags, err := request.NormalizeTags(req.Tags)
+	if err != nil {
+		return query, err
+	}
+	query.Tags = tags
+
+	if query.TagMatch == "" {
+		query.TagMatch = request.TagMatchAny
+	}
+	if !request.IsValidTagMatch(query.TagMatch) {
+		return query, fmt.Errorf("invalid tag_match (expected: any or all)")
+	}
+
+	if query.Sort == "" {
+		query.Sort = request.MemoSortCreatedAt
+	}
+	if !request.IsValidMemoSort(query.Sort) {
+		return query, fmt.Errorf("invalid sort (expected: created_at, updated_at, rating or distance)")
+	}
+
+	if query.Sort == request.MemoSortDistance {
+		if req.Latitude == nil || req.Longitude == nil {
+			return query, fmt.Errorf("lat and lng are required for distance sort")
+		}
+		if *req.Latitude < -90 || *req.Latitude > 90 || *req.Longitude < -180 || *req.Longitude > 180 {
+			return query, fmt.Errorf("lat/lng out of range")
+		}
+		query.Latitude = *req.Latitude
+		query.Longitude = *req.Longitude
+	}
+
+	if req.After != "" {
+		cursor, err := decodeMemoCursor(query, req.After)
+		if err != nil {
+			return query, err
+		}
+		query.Cursor = cursor
+	}
+
+	return query, nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"main/common/db/mysql"
+	"main/features/memo/model/request"
+	"strconv"
+	"time"
+)
+
+// memoCursorPayload 클라이언트에 전달되는 커서 내용 (base64url 인코딩된 JSON)
+type memoCursorPayload struct {
+	Sort        string   `json:"s"`
+	PinnedFirst bool     `json:"f"`
+	Latitude    *float64 `json:"la,omitempty"` // 거리순 정렬의 기준 위치
+	Longitude   *float64 `json:"ln,omitempty"`
+	IsPinned    bool     `json:"p"`
+	Value       string   `json:"v"`
+	ID          uint     `json:"i"`
+}
+
+// encodeMemoCursor 마지막 메모 기준으로 다음 페이지 커서 생성
+func encodeMemoCursor(query request.MemoListQuery, memo *mysql.Memo) string {
+	payload := memoCursorPayload{
+		Sort:        query.Sort,
+		PinnedFirst: query.PinnedFirst,
+		IsPinned:    memo.IsPinned,
+		ID:          memo.ID,
+	}
+
+	switch query.Sort {
+	case request.MemoSortUpdatedAt:
+		payload.Value = memo.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case request.MemoSortRating:
+		payload.Value = strconv.FormatUint(uint64(memo.Rating), 10)
+	case request.MemoSortDistance:
+		payload.Latitude, payload.Longitude = &query.Latitude, &query.Longitude
+		if memo.Distance != nil {
+			payload.Value = strconv.FormatFloat(*memo.Distance, 'g', -1, 64)
+		}
+	default:
+		payload.Value = memo.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	data, _ := json.Marshal(payload)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeMemoCursor 커서를 해석 (정렬 기준, pinned_first, 거리순 기준 위치가 다르면 에러)
+func decodeMemoCursor(query request.MemoListQuery, cursor string) (*request.MemoCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	var payload memoCursorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	if payload.Sort != query.Sort {
+		return nil, fmt.Errorf("cursor does not match sort order %q", query.Sort)
+	}
+
+	// 고정 메모 우선 여부에 따라 커서 조건이 달라지므로 같은 값으로만 이어서 조회
+	if payload.PinnedFirst != query.PinnedFirst {
+		return nil, fmt.Errorf("cursor does not match pinned_first=%t", query.PinnedFirst)
+	}
+
+	// 거리 값은 기준 위치에 따라 달라지므로 같은 위치로만 이어서 조회
+	if query.Sort == request.MemoSortDistance &&
+		(payload.Latitude == nil || payload.Longitude == nil || *payload.Latitude != query.Latitude || *payload.Longitude != query.Longitude) {
+		return nil, fmt.Errorf("cursor does not match lat/lng")
+	}
+
+	result := &request.MemoCursor{
+		IsPinned: payload.IsPinned,
+		ID:       payload.ID,
+	}
+
+	switch query.Sort {
+	case request.MemoSortRating:
+		rating, err := strconv.ParseUint(payload.Value, 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		result.Value = uint8(rating)
+	case request.MemoSortDistance:
+		distance, err := strconv.ParseFloat(payload.Value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		result.Value = distance
+	default:
+		t, err := time.Parse(time.RFC3339Nano, payload.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor")
+		}
+		result.Value = t
+	}
+
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"main/common/db/mysql"
+	"main/features/memo/model/request"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// rawCursor 페이로드 JSON을 그대로 인코딩한 커서
+func rawCursor(payload string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(payload))
+}
+
+// listQuery 커서에 영향을 주는 값만 설정한 목록 조회 조건
+func listQuery(sort string, pinnedFirst bool) request.MemoListQuery {
+	query := request.MemoListQuery{Sort: sort, PinnedFirst: pinnedFirst}
+	if sort == request.MemoSortDistance {
+		query.Latitude, query.Longitude = 37.5665, 126.978
+	}
+	return query
+}
+
+func TestDecodeMemoCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 9, 30, 15, 123456789, time.FixedZone("KST", 9*60*60))
+	updatedAt := createdAt.Add(time.Hour)
+	distance := 1234.5678
+	memo := &mysql.Memo{
+		Model:    gorm.Model{ID: 42, CreatedAt: createdAt, UpdatedAt: updatedAt},
+		Rating:   4,
+		IsPinned: true,
+		Distance: &distance,
+	}
+
+	tests := []struct {
+		sort        string
+		pinnedFirst bool
+		want        interface{}
+	}{
+		{sort: request.MemoSortCreatedAt, want: createdAt},
+		{sort: request.MemoSortCreatedAt, pinnedFirst: true, want: createdAt},
+		{sort: request.MemoSortUpdatedAt, want: updatedAt},
+		{sort: request.MemoSortRating, pinnedFirst: true, want: uint8(4)},
+		{sort: request.MemoSortDistance, want: distance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			query := listQuery(tt.sort, tt.pinnedFirst)
+			cursor, err := decodeMemoCursor(query, encodeMemoCursor(query, memo))
+			if err != nil {
+				t.Fatalf("decodeMemoCursor() error = %v", err)
+			}
+			if cursor.ID != memo.ID || cursor.IsPinned != memo.IsPinned {
+				t.Fatalf("decodeMemoCursor() = {ID: %d, IsPinned: %v}, want {ID: %d, IsPinned: %v}", cursor.ID, cursor.IsPinned, memo.ID, memo.IsPinned)
+			}
+
+			switch want := tt.want.(type) {
+			case time.Time:
+				got, ok := cursor.Value.(time.Time)
+				if !ok || !got.Equal(want) {
+					t.Fatalf("decodeMemoCursor() value = %v, want %v", cursor.Value, want)
+				}
+			default:
+				if cursor.Value != want {
+					t.Fatalf("decodeMemoCursor() value = %v (%T), want %v (%T)", cursor.Value, cursor.Value, want, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeMemoCursorErrors(t *testing.T) {
+	distance := 100.0
+	memo := &mysql.Memo{Model: gorm.Model{ID: 1, CreatedAt: time.Now()}, Distance: &distance}
+	movedOrigin := listQuery(request.MemoSortDistance, false)
+	movedOrigin.Longitude += 0.001
+
+	tests := []struct {
+		name        string
+		sort        string
+		pinnedFirst bool
+		query       *request.MemoListQuery // 없으면 sort, pinnedFirst로 생성
+		cursor      string
+	}{
+		{name: "not base64", sort: request.MemoSortCreatedAt, cursor: "!!not-base64!!"},
+		{name: "padded base64", sort: request.MemoSortCreatedAt, cursor: base64.URLEncoding.EncodeToString([]byte(`{"s":"created_at"}`))},
+		{name: "not json", sort: request.MemoSortCreatedAt, cursor: rawCursor("not json")},
+		{name: "sort mismatch", sort: request.MemoSortUpdatedAt, cursor: encodeMemoCursor(listQuery(request.MemoSortCreatedAt, false), memo)},
+		{name: "pinned_first mismatch (false to true)", sort: request.MemoSortCreatedAt, pinnedFirst: true, cursor: encodeMemoCursor(listQuery(request.MemoSortCreatedAt, false), memo)},
+		{name: "pinned_first mismatch (true to false)", sort: request.MemoSortCreatedAt, cursor: encodeMemoCursor(listQuery(request.MemoSortCreatedAt, true), memo)},
+		{name: "distance origin mismatch", query: &movedOrigin, cursor: encodeMemoCursor(listQuery(request.MemoSortDistance, false), memo)},
+		{name: "distance cursor without origin", sort: request.MemoSortDistance, cursor: rawCursor(`{"s":"distance","v":"100","i":1}`)},
+		{name: "cursor without pinned_first", sort: request.MemoSortRating, pinnedFirst: true, cursor: rawCursor(`{"s":"rating","p":false,"v":"3","i":1}`)},
+		{name: "invalid time", sort: request.MemoSortCreatedAt, cursor: rawCursor(`{"s":"created_at","v":"yesterday","i":1}`)},
+		{name: "rating out of range", sort: request.MemoSortRating, cursor: rawCursor(`{"s":"rating","v":"256","i":1}`)},
+		{name: "negative rating", sort: request.MemoSortRating, cursor: rawCursor(`{"s":"rating","v":"-1","i":1}`)},
+		{name: "distance without value", sort: request.MemoSortDistance, cursor: rawCursor(`{"s":"distance","la":37.5665,"ln":126.978,"v":"","i":1}`)},
+		{name: "empty", sort: request.MemoSortCreatedAt, cursor: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := listQuery(tt.sort, tt.pinnedFirst)
+			if tt.query != nil {
+				query = *tt.query
+			}
+			if cursor, err := decodeMemoCursor(query, tt.cursor); err == nil {
+				t.Fatalf("decodeMemoCursor() = %+v, want error", cursor)
+			}
+		})
+	}
+}
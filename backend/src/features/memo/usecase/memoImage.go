@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/storage"
+	"main/features/memo/model/request"
+	"mime/multipart"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// validateMemoImages 이미지 수와 설명 길이 검증 (existing: 메모에 이미 있는 이미지 수)
+func validateMemoImages(ctx context.Context, images []request.ReqMemoImage, existing int) error {
+	if existing+len(images) > request.MaxMemoImages {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("a memo can have at most %d images", request.MaxMemoImages), common.ErrFromClient)
+	}
+
+	for _, image := range images {
+		if image.Caption != nil && utf8.RuneCountInString(*image.Caption) > request.MaxMemoImageCaptionLen {
+			return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("caption must be at most %d characters", request.MaxMemoImageCaptionLen), common.ErrFromClient)
+		}
+	}
+
+	return nil
+}
+
+// photoMetadata 업로드한 사진들의 EXIF에서 처음 발견한 위치와 촬영 시각
+type photoMetadata struct {
+	Latitude  *float64
+	Longitude *float64
+	TakenAt   *time.Time
+}
+
+// uploadMemoImage 이미지를 크기별로 저장소에 업로드하고 memo_images 행으로 변환 (정렬 순서는 저장 시 결정)
+// 저장되는 이미지는 다시 인코딩되어 GPS 등 EXIF가 제거되며, 원본에서 읽은 EXIF는 함께 반환
+func uploadMemoImage(ctx context.Context, store storage.ObjectStorage, file multipart.File, caption *string) (*mysql.MemoImage, storage.ExifData, error) {
+	uploaded, err := storage.UploadImage(ctx, store, file, storage.MemoImageFolder)
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidImage) {
+			return nil, storage.ExifData{}, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+		return nil, storage.ExifData{}, fmt.Errorf("failed to upload image: %w", err)
+	}
+
+	return &mysql.MemoImage{
+		ObjectKey:   uploaded.Key,
+		HasVariants: true,
+		Caption:     caption,
+		Width:       uploaded.Width,
+		Height:      uploaded.Height,
+	}, uploaded.Exif, nil
+}
+
+// uploadMemoImages 여러 이미지를 순서대로 업로드
+func uploadMemoImages(ctx context.Context, store storage.ObjectStorage, images []request.ReqMemoImage) ([]mysql.MemoImage, *photoMetadata, error) {
+	uploaded := make([]mysql.MemoImage, 0, len(images))
+	metadata := &photoMetadata{}
+	for _, image := range images {
+		memoImage, exif, err := uploadMemoImage(ctx, store, image.File, image.Caption)
+		if err != nil {
+			return nil, nil, err
+		}
+		uploaded = append(uploaded, *memoImage)
+
+		if metadata.Latitude == nil && exif.Latitude != nil && exif.Longitude != nil {
+			metadata.Latitude, metadata.Longitude = exif.Latitude, exif.Longitude
+		}
+		if metadata.TakenAt == nil && exif.TakenAt != nil {
+			metadata.TakenAt = exif.TakenAt
+		}
+	}
+	return uploaded, metadata, nil
+}
+
+// deleteMemoImageObjects 메모 이미지 파일 삭제 (크기별 이미지가 있으면 모두 삭제)
+func deleteMemoImageObjects(ctx context.Context, store storage.ObjectStorage, image mysql.MemoImage) error {
+	if store == nil {
+		return storage.ErrNotConfigured
+	}
+	if isExternalURL(image.ObjectKey) {
+		return nil
+	}
+	if image.HasVariants {
+		return storage.DeleteImage(ctx, store, image.ObjectKey)
+	}
+	return store.Delete(ctx, image.ObjectKey)
+}
+
+// memoImageURLs 메모 이미지의 원본/중간 크기/썸네일 URL (크기별 이미지가 없으면 모두 원본 URL)
+func memoImageURLs(store storage.ObjectStorage, image mysql.MemoImage) (original, medium, thumbnail string) {
+	original = objectURL(store, image.ObjectKey)
+	if !image.HasVariants || isExternalURL(image.ObjectKey) {
+		return original, original, original
+	}
+	return original,
+		objectURL(store, storage.VariantKey(image.ObjectKey, storage.ImageVariantMedium)),
+		objectURL(store, storage.VariantKey(image.ObjectKey, storage.ImageVariantThumbnail))
+}
+
+// objectURL 저장소 객체 키를 공개 URL로 변환 (CDN/엔드포인트 설정을 따름, 기존 데이터의 전체 URL은 그대로 사용)
+func objectURL(store storage.ObjectStorage, key string) string {
+	if key == "" || store == nil || isExternalURL(key) {
+		return key
+	}
+	return store.URL(key)
+}
+
+// isExternalURL 객체 키가 아닌 전체 URL인지 확인 (키를 추출하지 못한 기존 데이터)
+func isExternalURL(key string) bool {
+	return strings.HasPrefix(key, "http://") || strings.HasPrefix(key, "https://")
+}
+
+// memoImageRef 요청으로 받은 이미지 URL을 객체 키 또는 외부 URL로 분리
+// 이 저장소의 URL이라도 서버가 발급한 키(issued가 true)만 객체 키로 저장하고, 나머지는 소유하지 않은 외부 URL로 저장
+// (객체 키로 저장한 이미지만 이미지 정리 대상이 되므로 다른 사용자의 객체를 메모 이미지로 가져오지 못하게 함)
+func memoImageRef(ctx context.Context, store storage.ObjectStorage, imageURL string, issued func(key string) (bool, error)) (key string, externalURL string, err error) {
+	if imageURL == "" || store == nil {
+		return "", imageURL, nil
+	}
+
+	key = store.KeyFromURL(imageURL)
+	if key == "" {
+		return "", imageURL, nil
+	}
+
+	ok, err := issued(key)
+	if err != nil {
+		return "", "", common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+	if !ok {
+		return "", imageURL, nil
+	}
+	return key, "", nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/storage"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"main/features/memo/model/response"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MemoImageUseCase struct {
+	Repository     _interface.IMemoImageRepository
+	Storage        storage.ObjectStorage
+	ContextTimeout time.Duration
+}
+
+func NewMemoImageUseCase(repo _interface.IMemoImageRepository, store storage.ObjectStorage, timeout time.Duration) _interface.IMemoImageUseCase {
+	return &MemoImageUseCase{
+		Repository:     repo,
+		Storage:        store,
+		ContextTimeout: timeout,
+	}
+}
+
+// ReorderMemoImages 메모 이미지 순서 변경 (방의 owner, editor만 가능, 첫 번째 이미지가 대표 이미지)
+func (uc *MemoImageUseCase) ReorderMemoImages(ctx context.Context, memoID uint, userID uint, req request.ReqReorderMemoImages) (*response.ResMemo, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	memo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		return nil, roomMemberDBError(ctx, err)
+	}
+
+	if err := checkEditPermission(ctx, member); err != nil {
+		return nil, err
+	}
+
+	// 메모의 모든 이미지 ID가 한 번씩 전달되어야 함
+	if len(req.ImageIDs) != len(memo.Images) {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("image_ids must list all %d images of the memo", len(memo.Images)), common.ErrFromClient)
+	}
+
+	remaining := make(map[uint]bool, len(memo.Images))
+	for _, image := range memo.Images {
+		remaining[image.ID] = true
+	}
+	for _, imageID := range req.ImageIDs {
+		if !remaining[imageID] {
+			return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("image %d is not part of the memo or is duplicated", imageID), common.ErrFromClient)
+		}
+		delete(remaining, imageID)
+	}
+
+	if err := uc.Repository.ReorderImages(ctx, memoID, req.ImageIDs); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	updatedMemo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	return convertMemoToResponse(updatedMemo, uc.Storage), nil
+}
+
+// DeleteMemoImage 메모 이미지 삭제 (방의 owner, editor만 가능, 대표 이미지를 삭제하면 다음 이미지가 대표 이미지)
+func (uc *MemoImageUseCase) DeleteMemoImage(ctx context.Context, memoID uint, imageID uint, userID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	memo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return memoDBError(ctx, err)
+	}
+
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	if err := checkEditPermission(ctx, member); err != nil {
+		return err
+	}
+
+	if err := uc.Repository.DeleteImage(ctx, memoID, imageID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "memo image not found", common.ErrFromClient)
+		}
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	// 저장소 파일 삭제 (실패해도 메모 이미지는 이미 삭제되었으므로 무시)
+	for _, image := range memo.Images {
+		if image.ID == imageID {
+			if err := deleteMemoImageObjects(ctx, uc.Storage, image); err != nil {
+				fmt.Printf("⚠️ 이미지 파일 삭제 실패: %v\n", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"main/common/db/mysql"
+	"main/features/memo/model/response"
+	"sort"
+	"strings"
+	"time"
+)
+
+// memoSnapshotFields 스냅샷 필드 순서 (diff 응답과 changed_fields에 사용)
+var memoSnapshotFields = []string{
+	"room_id", "title", "content", "rating", "is_pinned", "latitude", "longitude", "location_name", "category",
+	"visited_at", "is_wishlist", "business_name", "business_phone", "business_address", "naver_place_url", "tags",
+}
+
+// memoSnapshot 수정 이력에 저장하는 메모 필드 (이미지는 별도 API로 관리하므로 제외)
+type memoSnapshot struct {
+	RoomID          uint       `json:"room_id"`
+	Title           string     `json:"title"`
+	Content         string     `json:"content"`
+	Rating          uint8      `json:"rating"`
+	IsPinned        bool       `json:"is_pinned"`
+	Latitude        *float64   `json:"latitude"`
+	Longitude       *float64   `json:"longitude"`
+	LocationName    *string    `json:"location_name"`
+	Category        *string    `json:"category"`
+	VisitedAt       *time.Time `json:"visited_at"`
+	IsWishlist      bool       `json:"is_wishlist"`
+	BusinessName    *string    `json:"business_name"`
+	BusinessPhone   *string    `json:"business_phone"`
+	BusinessAddress *string    `json:"business_address"`
+	NaverPlaceURL   *string    `json:"naver_place_url"`
+	Tags            []string   `json:"tags"`
+}
+
+// newMemoSnapshot 메모의 현재 필드 스냅샷 (태그는 이름순으로 정렬해 순서 차이를 변경으로 보지 않음)
+func newMemoSnapshot(memo *mysql.Memo) memoSnapshot {
+	tags := memoTagNames(memo)
+	sort.Strings(tags)
+
+	return memoSnapshot{
+		RoomID:          memo.RoomID,
+		Title:           memo.Title,
+		Content:         memo.Content,
+		Rating:          memo.Rating,
+		IsPinned:        memo.IsPinned,
+		Latitude:        memo.Latitude,
+		Longitude:       memo.Longitude,
+		LocationName:    memo.LocationName,
+		Category:        memo.Category,
+		VisitedAt:       memo.VisitedAt,
+		IsWishlist:      memo.IsWishlist,
+		BusinessName:    memo.BusinessName,
+		BusinessPhone:   memo.BusinessPhone,
+		BusinessAddress: memo.BusinessAddress,
+		NaverPlaceURL:   memo.NaverPlaceURL,
+		Tags:            tags,
+	}
+}
+
+// parseMemoSnapshot 저장된 스냅샷 JSON 파싱
+func parseMemoSnapshot(data string) (memoSnapshot, error) {
+	var snapshot memoSnapshot
+	if err := json.Unmarshal([]byte(data), &snapshot); err != nil {
+		return memoSnapshot{}, fmt.Errorf("invalid memo snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// snapshotValues 스냅샷을 필드 이름별 JSON 값으로 변환
+func snapshotValues(snapshot memoSnapshot) map[string]json.RawMessage {
+	data, _ := json.Marshal(snapshot)
+	values := make(map[string]json.RawMessage, len(memoSnapshotFields))
+	_ = json.Unmarshal(data, &values)
+	return values
+}
+
+// diffMemoSnapshots 두 스냅샷의 필드 단위 차이 (변경된 필드만, memoSnapshotFields 순서)
+func diffMemoSnapshots(before, after memoSnapshot) []response.ResMemoFieldDiff {
+	beforeValues, afterValues := snapshotValues(before), snapshotValues(after)
+	changes := make([]response.ResMemoFieldDiff, 0)
+	for _, field := range memoSnapshotFields {
+		if bytes.Equal(beforeValues[field], afterValues[field]) {
+			continue
+		}
+		changes = append(changes, response.ResMemoFieldDiff{
+			Field:  field,
+			Before: beforeValues[field],
+			After:  afterValues[field],
+		})
+	}
+	return changes
+}
+
+// newMemoRevision 수정 전후 메모로 수정 이력 생성 (변경된 필드가 없으면 nil)
+func newMemoRevision(editorID uint, before, after *mysql.Memo) *mysql.MemoRevision {
+	beforeSnapshot, afterSnapshot := newMemoSnapshot(before), newMemoSnapshot(after)
+	changes := diffMemoSnapshots(beforeSnapshot, afterSnapshot)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	fields := make([]string, len(changes))
+	for i, change := range changes {
+		fields[i] = change.Field
+	}
+	beforeJSON, _ := json.Marshal(beforeSnapshot)
+	afterJSON, _ := json.Marshal(afterSnapshot)
+
+	return &mysql.MemoRevision{
+		MemoID:        after.ID,
+		EditorID:      editorID,
+		ChangedFields: strings.Join(fields, ","),
+		Before:        string(beforeJSON),
+		After:         string(afterJSON),
+	}
+}
+
+// memoRevisionBy 수정 전후 메모로 editorID의 수정 이력을 만드는 함수 (Repository가 수정과 같은 트랜잭션에서 저장)
+func memoRevisionBy(editorID uint) func(before, after *mysql.Memo) *mysql.MemoRevision {
+	return func(before, after *mysql.Memo) *mysql.MemoRevision {
+		return newMemoRevision(editorID, before, after)
+	}
+}
+
+// snapshotUpdates 스냅샷으로 되돌릴 컬럼 값 (changes에 있는 필드만, room_id와 tags는 제외)
+func snapshotUpdates(snapshot memoSnapshot, changes []response.ResMemoFieldDiff) map[string]interface{} {
+	values := map[string]interface{}{
+		"title":            snapshot.Title,
+		"content":          snapshot.Content,
+		"rating":           snapshot.Rating,
+		"is_pinned":        snapshot.IsPinned,
+		"latitude":         snapshot.Latitude,
+		"longitude":        snapshot.Longitude,
+		"location_name":    snapshot.LocationName,
+		"category":         snapshot.Category,
+		"visited_at":       snapshot.VisitedAt,
+		"is_wishlist":      snapshot.IsWishlist,
+		"business_name":    snapshot.BusinessName,
+		"business_phone":   snapshot.BusinessPhone,
+		"business_address": snapshot.BusinessAddress,
+		"naver_place_url":  snapshot.NaverPlaceURL,
+	}
+
+	updates := make(map[string]interface{})
+	for _, change := range changes {
+		if value, ok := values[change.Field]; ok {
+			updates[change.Field] = value
+		}
+	}
+
+	// 위치가 바뀌면 geohash도 함께 갱신
+	_, latChanged := updates["latitude"]
+	_, lngChanged := updates["longitude"]
+	if latChanged || lngChanged {
+		updates["geohash"] = memoGeohash(snapshot.Latitude, snapshot.Longitude)
+	}
+	return updates
+}
+
+// convertRevisionToResponse mysql.MemoRevision을 response.ResMemoRevision으로 변환
+func convertRevisionToResponse(revision *mysql.MemoRevision) response.ResMemoRevision {
+	editorName := "알 수 없음"
+	if revision.Editor != nil {
+		editorName = revision.Editor.Nickname
+		if editorName == "" {
+			editorName = revision.Editor.AccountID
+		}
+	}
+
+	changedFields := []string{}
+	if revision.ChangedFields != "" {
+		changedFields = strings.Split(revision.ChangedFields, ",")
+	}
+
+	return response.ResMemoRevision{
+		ID:            revision.ID,
+		MemoID:        revision.MemoID,
+		EditorID:      revision.EditorID,
+		EditorName:    editorName,
+		ChangedFields: changedFields,
+		CreatedAt:     revision.CreatedAt,
+	}
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/storage"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"main/features/memo/model/response"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type MemoRevisionUseCase struct {
+	Repository     _interface.IMemoRevisionRepository
+	Storage        storage.ObjectStorage
+	ContextTimeout time.Duration
+}
+
+func NewMemoRevisionUseCase(repo _interface.IMemoRevisionRepository, store storage.ObjectStorage, timeout time.Duration) _interface.IMemoRevisionUseCase {
+	return &MemoRevisionUseCase{
+		Repository:     repo,
+		Storage:        store,
+		ContextTimeout: timeout,
+	}
+}
+
+// GetRevisions 메모 수정 이력 목록 조회 (메모가 속한 방의 멤버만 가능)
+func (uc *MemoRevisionUseCase) GetRevisions(ctx context.Context, memoID uint, userID uint, req request.ReqGetRevisionList) (*response.ResMemoRevisionList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if _, _, err := uc.getMemoForMember(ctx, memoID, userID); err != nil {
+		return nil, err
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = request.DefaultMemoListLimit
+	}
+	if req.Limit > request.MaxMemoListLimit {
+		req.Limit = request.MaxMemoListLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	revisions, total, err := uc.Repository.GetRevisions(ctx, memoID, req)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	result := make([]response.ResMemoRevision, len(revisions))
+	for i := range revisions {
+		result[i] = convertRevisionToResponse(&revisions[i])
+	}
+
+	return &response.ResMemoRevisionList{
+		Revisions: result,
+		Total:     total,
+	}, nil
+}
+
+// GetRevisionDiff 수정 이력의 필드 단위 diff 조회 (메모가 속한 방의 멤버만 가능)
+// compare가 previous면 이 수정의 전후, current면 이 수정 직후와 현재 메모를 비교
+func (uc *MemoRevisionUseCase) GetRevisionDiff(ctx context.Context, memoID uint, revisionID uint, userID uint, compare string) (*response.ResMemoRevisionDiff, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if compare == "" {
+		compare = request.RevisionComparePrevious
+	}
+	if compare != request.RevisionComparePrevious && compare != request.RevisionCompareCurrent {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "compare must be previous or current", common.ErrFromClient)
+	}
+
+	memo, _, err := uc.getMemoForMember(ctx, memoID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := uc.getRevision(ctx, memoID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := parseMemoSnapshot(revision.After)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	var changes []response.ResMemoFieldDiff
+	if compare == request.RevisionCompareCurrent {
+		changes = diffMemoSnapshots(after, newMemoSnapshot(memo))
+	} else {
+		before, err := parseMemoSnapshot(revision.Before)
+		if err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+		}
+		changes = diffMemoSnapshots(before, after)
+	}
+
+	return &response.ResMemoRevisionDiff{
+		Revision: convertRevisionToResponse(revision),
+		Compare:  compare,
+		Changes:  changes,
+	}, nil
+}
+
+// RollbackMemo 메모를 수정 이력의 상태로 되돌림 (방의 owner, editor만 가능)
+// state가 after면 이 수정 직후, before면 이 수정 직전 상태로 되돌리며 롤백도 새 수정 이력으로 기록
+// 메모가 속한 방과 이미지는 되돌리지 않음
+func (uc *MemoRevisionUseCase) RollbackMemo(ctx context.Context, memoID uint, revisionID uint, userID uint, state string) (*response.ResMemo, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if state == "" {
+		state = request.RevisionStateAfter
+	}
+	if state != request.RevisionStateAfter && state != request.RevisionStateBefore {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "state must be after or before", common.ErrFromClient)
+	}
+
+	memo, member, err := uc.getMemoForMember(ctx, memoID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkEditPermission(ctx, member); err != nil {
+		return nil, err
+	}
+
+	revision, err := uc.getRevision(ctx, memoID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotJSON := revision.After
+	if state == request.RevisionStateBefore {
+		snapshotJSON = revision.Before
+	}
+	target, err := parseMemoSnapshot(snapshotJSON)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	changes := diffMemoSnapshots(newMemoSnapshot(memo), target)
+	updates := snapshotUpdates(target, changes)
+	replaceTags := false
+	for _, change := range changes {
+		if change.Field == "tags" {
+			replaceTags = true
+		}
+	}
+
+	// 되돌릴 필드가 없으면 (방만 다른 경우 포함) 현재 메모 그대로 반환
+	if len(updates) == 0 && !replaceTags {
+		return convertMemoToResponse(memo, uc.Storage), nil
+	}
+
+	tagNames := target.Tags
+	if tagNames == nil {
+		tagNames = []string{}
+	}
+	// 조회한 뒤 다른 요청이 먼저 수정했으면 현재 메모와 함께 412
+	if err := uc.Repository.Rollback(ctx, memoID, memo.Version, updates, tagNames, replaceTags, memoRevisionBy(userID)); err != nil {
+		return nil, memoVersionDBError(ctx, err, uc.Repository.GetByID, memoID, uc.Storage)
+	}
+
+	updatedMemo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	return convertMemoToResponse(updatedMemo, uc.Storage), nil
+}
+
+// getMemoForMember 메모와 메모가 속한 방의 멤버 정보 조회 (멤버가 아니면 에러)
+func (uc *MemoRevisionUseCase) getMemoForMember(ctx context.Context, memoID uint, userID uint) (*mysql.Memo, *mysql.RoomMember, error) {
+	memo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, nil, memoDBError(ctx, err)
+	}
+
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		return nil, nil, roomMemberDBError(ctx, err)
+	}
+
+	return memo, member, nil
+}
+
+// getRevision 메모의 수정 이력 조회
+func (uc *MemoRevisionUseCase) getRevision(ctx context.Context, memoID uint, revisionID uint) (*mysql.MemoRevision, error) {
+	revision, err := uc.Repository.GetRevision(ctx, memoID, revisionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "memo revision not found", common.ErrFromClient)
+		}
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+	return revision, nil
+}
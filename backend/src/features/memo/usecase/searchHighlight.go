@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	highlightOpenTag  = "<em>"
+	highlightCloseTag = "</em>"
+	snippetContext    = 30  // 첫 매칭 앞쪽에 포함할 글자 수
+	snippetMaxLength  = 120 // 발췌문 최대 글자 수
+)
+
+type matchRange struct {
+	start int
+	end   int
+}
+
+// splitSearchTerms 검색어를 공백 기준으로 분리 (대소문자 무시, 중복 제거)
+func splitSearchTerms(query string) [][]rune {
+	seen := make(map[string]bool)
+	var terms [][]rune
+	for _, field := range strings.Fields(query) {
+		term := strings.ToLower(field)
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, []rune(term))
+	}
+	return terms
+}
+
+// highlightSnippet 첫 매칭 주변의 발췌문을 만들고 검색어를 <em>으로 감싸서 반환 (매칭이 없으면 빈 문자열)
+// 원문은 HTML 이스케이프 처리
+func highlightSnippet(text string, terms [][]rune) string {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	matches := findMatches(lower, terms)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	// 첫 매칭이 포함되도록 발췌 범위 결정
+	start := matches[0].start - snippetContext
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetMaxLength
+	if end < matches[0].end {
+		end = matches[0].end
+	}
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range matches {
+		if m.start < start || m.end > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:m.start])))
+		b.WriteString(highlightOpenTag)
+		b.WriteString(html.EscapeString(string(runes[m.start:m.end])))
+		b.WriteString(highlightCloseTag)
+		pos = m.end
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
+
+// findMatches 모든 검색어의 매칭 위치를 찾아 겹치지 않게 정렬해서 반환
+func findMatches(text []rune, terms [][]rune) []matchRange {
+	var matches []matchRange
+	for _, term := range terms {
+		if len(term) == 0 {
+			continue
+		}
+		for i := 0; i+len(term) <= len(text); i++ {
+			if runesEqual(text[i:i+len(term)], term) {
+				matches = append(matches, matchRange{start: i, end: i + len(term)})
+				i += len(term) - 1
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].start == matches[j].start {
+			return matches[i].end > matches[j].end
+		}
+		return matches[i].start < matches[j].start
+	})
+
+	// 겹치는 매칭 제거 (앞에 있는 긴 매칭 우선)
+	merged := matches[:0]
+	for _, m := range matches {
+		if len(merged) > 0 && m.start < merged[len(merged)-1].end {
+			continue
+		}
+		merged = append(merged, m)
+	}
+
+	return merged
+}
+
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
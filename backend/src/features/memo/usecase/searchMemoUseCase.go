@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/storage"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"main/features/memo/model/response"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type SearchMemoUseCase struct {
+	Repository     _interface.ISearchMemoRepository
+	Storage        storage.ObjectStorage
+	ContextTimeout time.Duration
+}
+
+func NewSearchMemoUseCase(repo _interface.ISearchMemoRepository, store storage.ObjectStorage, timeout time.Duration) _interface.ISearchMemoUseCase {
+	return &SearchMemoUseCase{
+		Repository:     repo,
+		Storage:        store,
+		ContextTimeout: timeout,
+	}
+}
+
+// SearchMemo 사용자가 속한 방들의 메모 전문 검색 (제목, 내용, 장소명, 가게명, 주소)
+func (uc *SearchMemoUseCase) SearchMemo(ctx context.Context, userID uint, req request.ReqSearchMemo) (*response.ResMemoSearchList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	req.Query = strings.TrimSpace(req.Query)
+	queryLength := utf8.RuneCountInString(req.Query)
+	if queryLength < request.MinSearchQueryLength || queryLength > request.MaxSearchQueryLength {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "q must be between 2 and 100 characters", common.ErrFromClient)
+	}
+
+	tags, err := request.NormalizeTags(req.Tags)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+	req.Tags = tags
+
+	if req.TagMatch == "" {
+		req.TagMatch = request.TagMatchAny
+	}
+	if !request.IsValidTagMatch(req.TagMatch) {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid tag_match (expected: any or all)", common.ErrFromClient)
+	}
+
+	if req.Limit == 0 {
+		req.Limit = request.DefaultMemoListLimit
+	}
+	if req.Limit < 1 || req.Limit > request.MaxMemoListLimit {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "limit must be between 1 and 100", common.ErrFromClient)
+	}
+	if req.Offset < 0 {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "offset must not be negative", common.ErrFromClient)
+	}
+
+	// 특정 방을 지정한 경우 멤버인지 확인
+	if req.RoomID != nil {
+		if _, err := uc.Repository.GetRoomMember(ctx, *req.RoomID, userID); err != nil {
+			return nil, roomMemberDBError(ctx, err)
+		}
+	}
+
+	total, err := uc.Repository.CountSearch(ctx, userID, req)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	memos, err := uc.Repository.Search(ctx, userID, req)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	terms := splitSearchTerms(req.Query)
+	results := make([]response.ResMemoSearchResult, len(memos))
+	for i, memo := range memos {
+		results[i] = response.ResMemoSearchResult{
+			Memo:       *convertMemoToResponse(&memo, uc.Storage),
+			Highlights: buildMemoHighlights(&memo, terms),
+		}
+		if memo.Relevance != nil {
+			results[i].Relevance = *memo.Relevance
+		}
+	}
+
+	return &response.ResMemoSearchList{
+		Results: results,
+		Total:   total,
+	}, nil
+}
+
+// buildMemoHighlights 검색 대상 필드별 강조된 발췌문 생성 (매칭된 필드만 포함)
+func buildMemoHighlights(memo *mysql.Memo, terms [][]rune) map[string]string {
+	fields := map[string]*string{
+		"title":            &memo.Title,
+		"content":          &memo.Content,
+		"location_name":    memo.LocationName,
+		"business_name":    memo.BusinessName,
+		"business_address": memo.BusinessAddress,
+	}
+
+	highlights := make(map[string]string)
+	for name, value := range fields {
+		if value == nil || *value == "" {
+			continue
+		}
+		if snippet := highlightSnippet(*value, terms); snippet != "" {
+			highlights[name] = snippet
+		}
+	}
+
+	return highlights
+}
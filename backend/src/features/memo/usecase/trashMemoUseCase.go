@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/storage"
+	_interface "main/features/memo/model/interface"
+	"main/features/memo/model/request"
+	"main/features/memo/model/response"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// trashPurgeBatchSize 영구 삭제 작업에서 한 번에 처리하는 메모 수
+const trashPurgeBatchSize = 100
+
+type TrashMemoUseCase struct {
+	Repository     _interface.ITrashMemoRepository
+	Storage        storage.ObjectStorage
+	Retention      time.Duration // 휴지통 보관 기간 (지나면 영구 삭제)
+	ContextTimeout time.Duration
+}
+
+func NewTrashMemoUseCase(repo _interface.ITrashMemoRepository, store storage.ObjectStorage, retention time.Duration, timeout time.Duration) _interface.ITrashMemoUseCase {
+	return &TrashMemoUseCase{
+		Repository:     repo,
+		Storage:        store,
+		Retention:      retention,
+		ContextTimeout: timeout,
+	}
+}
+
+// GetTrashList 휴지통 메모 목록 조회 (복원/영구 삭제할 수 있는 메모, 즉 작성자이거나 방 소유자인 메모만)
+func (uc *TrashMemoUseCase) GetTrashList(ctx context.Context, userID uint, req request.ReqGetTrashList) (*response.ResTrashMemoList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if req.Limit <= 0 {
+		req.Limit = request.DefaultMemoListLimit
+	}
+	if req.Limit > request.MaxMemoListLimit {
+		req.Limit = request.MaxMemoListLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	memos, total, err := uc.Repository.GetTrashList(ctx, userID, req)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	result := make([]response.ResTrashMemo, len(memos))
+	for i := range memos {
+		deletedAt := memos[i].DeletedAt.Time
+		result[i] = response.ResTrashMemo{
+			ResMemo:   *convertMemoToResponse(&memos[i], uc.Storage),
+			DeletedAt: deletedAt,
+			PurgeAt:   deletedAt.Add(uc.Retention),
+		}
+	}
+
+	return &response.ResTrashMemoList{
+		Memos: result,
+		Total: total,
+	}, nil
+}
+
+// RestoreMemo 휴지통 메모 복원 (작성자 또는 방 소유자만 가능, 메모와 함께 숨겨진 댓글도 복원)
+func (uc *TrashMemoUseCase) RestoreMemo(ctx context.Context, memoID uint, userID uint) (*response.ResMemo, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	memo, err := uc.Repository.GetTrashedByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	if err := uc.checkPermission(ctx, memo, userID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.Repository.Restore(ctx, memoID); err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	restored, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	return convertMemoToResponse(restored, uc.Storage), nil
+}
+
+// PurgeMemo 휴지통 메모 영구 삭제 (작성자 또는 방 소유자만 가능, 휴지통에 없는 메모는 ErrNotFound)
+// 댓글, 태그 연결, 이미지 행과 저장소 파일을 함께 삭제하며, version은 If-Match로 받은 메모 버전으로 현재 버전과 다르면 삭제하지 않음 (0이면 확인하지 않음)
+func (uc *TrashMemoUseCase) PurgeMemo(ctx context.Context, memoID uint, userID uint, version uint) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	memo, err := uc.Repository.GetTrashedByID(ctx, memoID)
+	if err != nil {
+		return memoDBError(ctx, err)
+	}
+
+	if err := uc.checkPermission(ctx, memo, userID); err != nil {
+		return err
+	}
+
+	if err := checkMemoVersion(ctx, memo, version, uc.Storage); err != nil {
+		return err
+	}
+
+	if _, err := uc.Repository.HardDelete(ctx, []uint{memoID}, version); err != nil {
+		return memoVersionDBError(ctx, err, uc.Repository.GetTrashedByID, memoID, uc.Storage)
+	}
+
+	// 저장소 파일 삭제 (실패한 파일은 이미지 정리 작업에서 다시 정리됨)
+	if _, failed := uc.deleteMemoObjects(ctx, []mysql.Memo{*memo}); failed > 0 {
+		fmt.Printf("⚠️ 메모 %d 이미지 파일 %d개 삭제 실패\n", memoID, failed)
+	}
+
+	return nil
+}
+
+// PurgeExpiredTrash 보관 기간이 지난 휴지통 메모를 댓글, 이미지와 함께 영구 삭제 (주기 작업에서 실행)
+func (uc *TrashMemoUseCase) PurgeExpiredTrash(ctx context.Context) (*response.ResTrashPurge, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	report := &response.ResTrashPurge{}
+	deletedBefore := time.Now().Add(-uc.Retention)
+	for {
+		memos, err := uc.Repository.GetExpiredTrash(ctx, deletedBefore, trashPurgeBatchSize)
+		if err != nil {
+			return report, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+		if len(memos) == 0 {
+			return report, nil
+		}
+
+		ids := make([]uint, len(memos))
+		for i, memo := range memos {
+			ids[i] = memo.ID
+		}
+		purgedIDs, err := uc.Repository.HardDelete(ctx, ids, 0)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return report, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+		report.Purged += len(purgedIDs)
+
+		// 조회 이후 복원된 메모의 이미지는 남겨둠
+		deleted, failed := uc.deleteMemoObjects(ctx, filterMemosByID(memos, purgedIDs))
+		report.DeletedImages += deleted
+		report.FailedImages += failed
+
+		if len(memos) < trashPurgeBatchSize {
+			return report, nil
+		}
+	}
+}
+
+// filterMemosByID ids에 포함된 메모만 반환
+func filterMemosByID(memos []mysql.Memo, ids []uint) []mysql.Memo {
+	keep := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		keep[id] = true
+	}
+
+	filtered := make([]mysql.Memo, 0, len(ids))
+	for _, memo := range memos {
+		if keep[memo.ID] {
+			filtered = append(filtered, memo)
+		}
+	}
+	return filtered
+}
+
+// checkPermission 휴지통 메모 복원/영구 삭제 권한 확인 (삭제 권한과 동일)
+func (uc *TrashMemoUseCase) checkPermission(ctx context.Context, memo *mysql.Memo, userID uint) error {
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+	return checkDeletePermission(ctx, memo, member)
+}
+
+// deleteMemoObjects 영구 삭제한 메모들의 이미지 파일 삭제 (메모가 소유한 memo_images 행의 객체만 삭제)
+// 대표 이미지 키(image_key)는 다른 메모나 사용자의 객체를 가리킬 수 있으므로 직접 삭제하지 않음 (더 이상 참조되지 않으면 이미지 정리 작업에서 삭제)
+// 기존 이미지(크기별 이미지 없음)는 원본 키만 삭제
+func (uc *TrashMemoUseCase) deleteMemoObjects(ctx context.Context, memos []mysql.Memo) (deleted int, failed int) {
+	if uc.Storage == nil {
+		return 0, 0
+	}
+
+	for _, memo := range memos {
+		for _, image := range memo.Images {
+			if isExternalURL(image.ObjectKey) {
+				continue
+			}
+			if err := deleteMemoImageObjects(ctx, uc.Storage, image); err != nil {
+				fmt.Printf("⚠️ 이미지 파일 삭제 실패 (key=%s): %v\n", image.ObjectKey, err)
+				failed++
+				continue
+			}
+			deleted++
+		}
+	}
+	return deleted, failed
+}
@@ -2,83 +2,340 @@ package usecase
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"main/common"
 	"main/common/db/mysql"
 	"main/common/storage"
 	_interface "main/features/memo/model/interface"
 	"main/features/memo/model/request"
 	"main/features/memo/model/response"
-
+	"strings"
 	"time"
 )
 
 type UpdateMemoUseCase struct {
 	Repository     _interface.IUpdateMemoRepository
+	Storage        storage.ObjectStorage
 	ContextTimeout time.Duration
 }
 
-func NewUpdateMemoUseCase(repo _interface.IUpdateMemoRepository, timeout time.Duration) _interface.IUpdateMemoUseCase {
+func NewUpdateMemoUseCase(repo _interface.IUpdateMemoRepository, store storage.ObjectStorage, timeout time.Duration) _interface.IUpdateMemoUseCase {
 	return &UpdateMemoUseCase{
 		Repository:     repo,
+		Storage:        store,
 		ContextTimeout: timeout,
 	}
 }
 
-// UpdateMemo 메모 수정
-func (uc *UpdateMemoUseCase) UpdateMemo(ctx context.Context, memoID uint, userID uint, req request.ReqUpdateMemo) (*response.ResMemo, error) {
+// UpdateMemo 메모 수정 (방의 owner, editor만 가능)
+// version은 If-Match로 받은 메모 버전으로 현재 버전과 다르면 수정하지 않음 (0이면 확인하지 않음)
+func (uc *UpdateMemoUseCase) UpdateMemo(ctx context.Context, memoID uint, userID uint, version uint, req request.ReqUpdateMemo) (*response.ResMemo, error) {
 	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
 	defer cancel()
 
 	// 비즈니스 정보 필드 검증
 	if err := request.ValidateBusinessFields(req.BusinessName, req.BusinessPhone, req.BusinessAddress); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	// 태그 검증 (nil이면 기존 태그 유지)
+	var tagNames []string
+	if req.Tags != nil {
+		normalized, err := request.NormalizeTags(req.Tags)
+		if err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+		tagNames = normalized
+	}
+
+	// 메모가 속한 방의 권한 확인
+	memo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		return nil, roomMemberDBError(ctx, err)
+	}
+
+	if err := checkEditPermission(ctx, member); err != nil {
 		return nil, err
 	}
 
-	// 이미지 파일이 있으면 S3에 업로드
-	imageURL := req.ImageURL
-	if req.ImageFile != nil && req.ImageHeader != nil {
-		if storage.S3 == nil {
-			return nil, fmt.Errorf("S3 storage is not configured")
+	// If-Match 버전 확인 (다른 요청이 먼저 수정했으면 현재 메모와 함께 412)
+	if err := checkMemoVersion(ctx, memo, version, uc.Storage); err != nil {
+		return nil, err
+	}
+
+	// 다른 방으로 이동하는 경우 기존 방에서는 삭제 권한, 대상 방에서는 작성 권한 필요
+	var targetRoomID uint
+	if req.RoomID != nil && *req.RoomID != memo.RoomID {
+		if err := uc.checkRoomMove(ctx, memo, member, *req.RoomID, userID); err != nil {
+			return nil, err
 		}
+		targetRoomID = *req.RoomID
 
-		// 기존 이미지가 있으면 삭제 (선택사항)
-		// oldMemo, _ := uc.Repository.GetByID(ctx, memoID, userID)
-		// if oldMemo != nil && oldMemo.ImageURL != "" {
-		//     storage.S3.DeleteFile(ctx, oldMemo.ImageURL)
-		// }
+		// 태그는 방 단위이므로 기존 태그를 이동할 방의 태그로 다시 연결
+		if tagNames == nil {
+			tagNames = memoTagNames(memo)
+		}
+	}
 
-		uploadedURL, err := storage.S3.UploadFile(ctx, req.ImageFile, req.ImageHeader, "image/daily")
+	// 이미지 업로드 (image 파일은 대표 이미지 교체, images[]는 기존 이미지 뒤에 추가)
+	existingImages := len(memo.Images)
+	if req.ImageFile != nil && req.ImageHeader != nil && existingImages == 0 {
+		existingImages = 1
+	}
+	if err := validateMemoImages(ctx, req.Images, existingImages); err != nil {
+		return nil, err
+	}
+
+	var cover *mysql.MemoImage
+	if req.ImageFile != nil && req.ImageHeader != nil {
+		cover, _, err = uploadMemoImage(ctx, uc.Storage, req.ImageFile, nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to upload image to S3: %w", err)
+			return nil, err
 		}
-		imageURL = uploadedURL
+	}
+
+	images, _, err := uploadMemoImages(ctx, uc.Storage, req.Images)
+	if err != nil {
+		return nil, err
+	}
+
+	// image_url이 서버가 발급한 이 저장소의 URL이면 객체 키로 저장 (이미지 파일이 함께 오면 대표 이미지로 다시 동기화됨)
+	imageKey, imageURL, err := memoImageRef(ctx, uc.Storage, req.ImageURL, func(key string) (bool, error) {
+		return uc.Repository.IsIssuedImageKey(ctx, userID, memoID, key)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	updateMemo := &mysql.Memo{
+		RoomID:          targetRoomID,
 		Title:           req.Title,
 		Content:         req.Content,
 		ImageURL:        imageURL,
+		ImageKey:        imageKey,
 		Rating:          req.Rating,
 		IsPinned:        req.IsPinned,
 		Latitude:        req.Latitude,
 		Longitude:       req.Longitude,
 		LocationName:    req.LocationName,
+		Category:        req.Category,
+		VisitedAt:       req.VisitedAt,
 		IsWishlist:      req.IsWishlist,
 		BusinessName:    req.BusinessName,
 		BusinessPhone:   req.BusinessPhone,
 		BusinessAddress: req.BusinessAddress,
+		NaverPlaceURL:   req.NaverPlaceURL,
 	}
 
-	err := uc.Repository.Update(ctx, memoID, userID, updateMemo)
-	if err != nil {
-		return nil, err
+	// 위치가 변경되면 geohash도 함께 갱신
+	if req.Latitude != nil || req.Longitude != nil {
+		lat, lng := memo.Latitude, memo.Longitude
+		if req.Latitude != nil {
+			lat = req.Latitude
+		}
+		if req.Longitude != nil {
+			lng = req.Longitude
+		}
+		updateMemo.Geohash = memoGeohash(lat, lng)
+	}
+
+	// 수정 이력(수정한 사용자, 시각, 변경된 필드)도 함께 저장
+	if err := uc.Repository.Update(ctx, memoID, version, updateMemo, tagNames, cover, images, memoRevisionBy(userID)); err != nil {
+		return nil, memoVersionDBError(ctx, err, uc.Repository.GetByID, memoID, uc.Storage)
 	}
 
 	// 업데이트된 메모 조회
-	updatedMemo, err := uc.Repository.GetByID(ctx, memoID, userID)
+	updatedMemo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	return convertMemoToResponse(updatedMemo, uc.Storage), nil
+}
+
+// PatchMemo 메모 부분 수정 (JSON merge-patch, 방의 owner, editor만 가능)
+// 요청에 있는 필드만 변경하며 null은 값 제거, false/0/빈 문자열도 그대로 저장
+// version은 If-Match로 받은 메모 버전으로 현재 버전과 다르면 수정하지 않음 (0이면 확인하지 않음)
+func (uc *UpdateMemoUseCase) PatchMemo(ctx context.Context, memoID uint, userID uint, version uint, req request.ReqPatchMemo) (*response.ResMemo, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if err := validatePatchMemo(req); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	// 태그 검증 (null이면 모든 태그 제거)
+	var tagNames []string
+	if req.Tags.Set {
+		normalized, err := request.NormalizeTags(req.Tags.Value)
+		if err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+		tagNames = normalized
+	}
+
+	// 메모가 속한 방의 권한 확인
+	memo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
 	if err != nil {
+		return nil, roomMemberDBError(ctx, err)
+	}
+
+	if err := checkEditPermission(ctx, member); err != nil {
 		return nil, err
 	}
 
-	return convertMemoToResponse(updatedMemo), nil
+	// If-Match 버전 확인 (다른 요청이 먼저 수정했으면 현재 메모와 함께 412)
+	if err := checkMemoVersion(ctx, memo, version, uc.Storage); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	// 다른 방으로 이동 (태그는 이동할 방의 태그로 다시 연결)
+	if req.RoomID.Set && req.RoomID.Value != memo.RoomID {
+		if err := uc.checkRoomMove(ctx, memo, member, req.RoomID.Value, userID); err != nil {
+			return nil, err
+		}
+		updates["room_id"] = req.RoomID.Value
+		if !req.Tags.Set {
+			tagNames = memoTagNames(memo)
+		}
+	}
+
+	setPatchColumn(updates, "title", req.Title)
+	setPatchColumn(updates, "content", req.Content)
+	setPatchColumn(updates, "rating", req.Rating)
+	setPatchColumn(updates, "is_pinned", req.IsPinned)
+	setPatchColumn(updates, "is_wishlist", req.IsWishlist)
+	setNullablePatchColumn(updates, "latitude", req.Latitude)
+	setNullablePatchColumn(updates, "longitude", req.Longitude)
+	setNullablePatchColumn(updates, "location_name", req.LocationName)
+	setNullablePatchColumn(updates, "category", req.Category)
+	setNullablePatchColumn(updates, "visited_at", req.VisitedAt)
+	setNullablePatchColumn(updates, "business_name", req.BusinessName)
+	setNullablePatchColumn(updates, "business_phone", req.BusinessPhone)
+	setNullablePatchColumn(updates, "business_address", req.BusinessAddress)
+	setNullablePatchColumn(updates, "naver_place_url", req.NaverPlaceURL)
+
+	// 대표 이미지: 서버가 발급한 이 저장소의 URL이면 객체 키로 저장, null이면 대표 이미지 제거
+	if req.ImageURL.Set {
+		imageKey, imageURL, err := memoImageRef(ctx, uc.Storage, req.ImageURL.Value, func(key string) (bool, error) {
+			return uc.Repository.IsIssuedImageKey(ctx, userID, memoID, key)
+		})
+		if err != nil {
+			return nil, err
+		}
+		updates["image_key"] = imageKey
+		updates["image_url"] = imageURL
+	}
+
+	// 위치가 변경되면 geohash도 함께 갱신 (위도/경도 중 하나라도 제거되면 geohash도 제거)
+	if req.Latitude.Set || req.Longitude.Set {
+		lat, lng := patchedFloat(memo.Latitude, req.Latitude), patchedFloat(memo.Longitude, req.Longitude)
+		if lat != nil && lng != nil && !isValidCoordinate(*lat, *lng) {
+			return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "lat/lng out of range", common.ErrFromClient)
+		}
+		updates["geohash"] = memoGeohash(lat, lng)
+	}
+
+	// 수정 이력(수정한 사용자, 시각, 변경된 필드)도 함께 저장
+	if err := uc.Repository.Patch(ctx, memoID, version, updates, tagNames, req.Tags.Set || updates["room_id"] != nil, memoRevisionBy(userID)); err != nil {
+		return nil, memoVersionDBError(ctx, err, uc.Repository.GetByID, memoID, uc.Storage)
+	}
+
+	updatedMemo, err := uc.Repository.GetByID(ctx, memoID)
+	if err != nil {
+		return nil, memoDBError(ctx, err)
+	}
+
+	return convertMemoToResponse(updatedMemo, uc.Storage), nil
+}
+
+// checkRoomMove 메모를 다른 방으로 옮길 권한 확인 (기존 방에서는 삭제 권한, 대상 방에서는 작성 권한 필요)
+func (uc *UpdateMemoUseCase) checkRoomMove(ctx context.Context, memo *mysql.Memo, member *mysql.RoomMember, targetRoomID uint, userID uint) error {
+	if err := checkDeletePermission(ctx, memo, member); err != nil {
+		return err
+	}
+
+	targetMember, err := uc.Repository.GetRoomMember(ctx, targetRoomID, userID)
+	if err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	return checkEditPermission(ctx, targetMember)
+}
+
+// memoTagNames 메모에 연결된 태그 이름 목록
+func memoTagNames(memo *mysql.Memo) []string {
+	names := make([]string, len(memo.Tags))
+	for i, tag := range memo.Tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+// validatePatchMemo null을 허용하지 않는 필드와 값 범위 검증
+func validatePatchMemo(req request.ReqPatchMemo) error {
+	if req.RoomID.Null {
+		return errors.New("room_id cannot be null")
+	}
+	if req.Title.Set && strings.TrimSpace(req.Title.Value) == "" {
+		return errors.New("title cannot be null or empty")
+	}
+	if req.Rating.Value > 5 {
+		return errors.New("rating must be between 0 and 5")
+	}
+
+	var businessName, businessPhone, businessAddress *string
+	if req.BusinessName.Set && !req.BusinessName.Null {
+		businessName = &req.BusinessName.Value
+	}
+	if req.BusinessPhone.Set && !req.BusinessPhone.Null {
+		businessPhone = &req.BusinessPhone.Value
+	}
+	if req.BusinessAddress.Set && !req.BusinessAddress.Null {
+		businessAddress = &req.BusinessAddress.Value
+	}
+	return request.ValidateBusinessFields(businessName, businessPhone, businessAddress)
+}
+
+// setPatchColumn NOT NULL 컬럼 변경 (null이면 타입의 기본값으로 초기화)
+func setPatchColumn[T any](updates map[string]interface{}, column string, field request.PatchField[T]) {
+	if field.Set {
+		updates[column] = field.Value
+	}
+}
+
+// setNullablePatchColumn NULL 허용 컬럼 변경 (null이면 NULL로 저장)
+func setNullablePatchColumn[T any](updates map[string]interface{}, column string, field request.PatchField[T]) {
+	if !field.Set {
+		return
+	}
+	if field.Null {
+		updates[column] = nil
+		return
+	}
+	updates[column] = field.Value
+}
+
+// patchedFloat 변경 후의 값 (요청에 없으면 기존 값, null이면 nil)
+func patchedFloat(current *float64, field request.PatchField[float64]) *float64 {
+	if !field.Set {
+		return current
+	}
+	if field.Null {
+		return nil
+	}
+	value := field.Value
+	return &value
 }
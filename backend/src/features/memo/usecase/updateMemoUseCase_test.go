@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"main/features/memo/model/request"
+	"reflect"
+	"testing"
+)
+
+func TestSetPatchColumns(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func(updates map[string]interface{})
+		want  map[string]interface{}
+	}{
+		{
+			name: "absent fields are not updated",
+			apply: func(updates map[string]interface{}) {
+				setPatchColumn(updates, "title", request.PatchField[string]{})
+				setNullablePatchColumn(updates, "category", request.PatchField[string]{})
+			},
+			want: map[string]interface{}{},
+		},
+		{
+			name: "null on NOT NULL column resets to zero value",
+			apply: func(updates map[string]interface{}) {
+				setPatchColumn(updates, "is_pinned", request.PatchField[bool]{Set: true, Null: true})
+				setPatchColumn(updates, "rating", request.PatchField[uint8]{Set: true, Null: true})
+			},
+			want: map[string]interface{}{"is_pinned": false, "rating": uint8(0)},
+		},
+		{
+			name: "null on nullable column stores NULL",
+			apply: func(updates map[string]interface{}) {
+				setNullablePatchColumn(updates, "category", request.PatchField[string]{Set: true, Null: true})
+				setNullablePatchColumn(updates, "latitude", request.PatchField[float64]{Set: true, Null: true})
+			},
+			want: map[string]interface{}{"category": nil, "latitude": nil},
+		},
+		{
+			name: "zero values are stored as is",
+			apply: func(updates map[string]interface{}) {
+				setPatchColumn(updates, "content", request.PatchField[string]{Set: true, Value: ""})
+				setPatchColumn(updates, "is_wishlist", request.PatchField[bool]{Set: true, Value: false})
+				setNullablePatchColumn(updates, "location_name", request.PatchField[string]{Set: true, Value: ""})
+				setNullablePatchColumn(updates, "longitude", request.PatchField[float64]{Set: true, Value: 0})
+			},
+			want: map[string]interface{}{"content": "", "is_wishlist": false, "location_name": "", "longitude": float64(0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := make(map[string]interface{})
+			tt.apply(updates)
+			if !reflect.DeepEqual(updates, tt.want) {
+				t.Fatalf("updates = %#v, want %#v", updates, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatchedFloat(t *testing.T) {
+	current := 37.5
+
+	tests := []struct {
+		name    string
+		current *float64
+		field   request.PatchField[float64]
+		want    *float64
+	}{
+		{name: "absent keeps current", current: &current, field: request.PatchField[float64]{}, want: &current},
+		{name: "absent keeps nil", current: nil, field: request.PatchField[float64]{}, want: nil},
+		{name: "null clears", current: &current, field: request.PatchField[float64]{Set: true, Null: true}, want: nil},
+		{name: "value replaces", current: &current, field: request.PatchField[float64]{Set: true, Value: 126.9}, want: ptrFloat(126.9)},
+		{name: "zero replaces", current: &current, field: request.PatchField[float64]{Set: true, Value: 0}, want: ptrFloat(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := patchedFloat(tt.current, tt.field)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Fatalf("patchedFloat() = %v, want %v", deref(got), deref(tt.want))
+			}
+		})
+	}
+}
+
+func ptrFloat(v float64) *float64 {
+	return &v
+}
+
+func deref(v *float64) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -1,13 +1,20 @@
 package usecase
 
 import (
+	"context"
+	"errors"
+	"main/common"
 	"main/common/db/mysql"
+	"main/common/storage"
 	commentResponse "main/features/comment/model/response"
 	"main/features/memo/model/response"
+
+	"gorm.io/gorm"
 )
 
 // convertMemoToResponse mysql.Memo를 response.ResMemo로 변환
-func convertMemoToResponse(memo *mysql.Memo) *response.ResMemo {
+// 이미지 URL은 저장된 객체 키로부터 현재 저장소 설정(CDN, 엔드포인트)에 맞게 생성
+func convertMemoToResponse(memo *mysql.Memo, store storage.ObjectStorage) *response.ResMemo {
 	// 댓글 변환
 	comments := make([]commentResponse.ResComment, len(memo.Comments))
 	for i, comment := range memo.Comments {
@@ -31,25 +38,140 @@ func convertMemoToResponse(memo *mysql.Memo) *response.ResMemo {
 		}
 	}
 
+	// 태그 변환
+	tags := make([]string, len(memo.Tags))
+	for i, tag := range memo.Tags {
+		tags[i] = tag.Name
+	}
+
+	// 이미지 변환 (크기별 이미지가 없는 기존 이미지는 원본 URL 사용)
+	imageURL := objectURL(store, memo.ImageKey)
+	if imageURL == "" {
+		imageURL = memo.ImageURL
+	}
+	imageMediumURL, imageThumbnailURL := imageURL, imageURL
+
+	images := make([]response.ResMemoImage, len(memo.Images))
+	for i, image := range memo.Images {
+		original, medium, thumbnail := memoImageURLs(store, image)
+		if memo.ImageKey != "" && image.ObjectKey == memo.ImageKey {
+			imageMediumURL, imageThumbnailURL = medium, thumbnail
+		}
+
+		images[i] = response.ResMemoImage{
+			ID:           image.ID,
+			ImageURL:     original,
+			MediumURL:    medium,
+			ThumbnailURL: thumbnail,
+			SortOrder:    image.SortOrder,
+			Caption:      image.Caption,
+			Width:        image.Width,
+			Height:       image.Height,
+		}
+	}
+
 	return &response.ResMemo{
-		ID:              memo.ID,
-		UserID:          memo.UserID,
-		Title:           memo.Title,
-		Content:         memo.Content,
-		ImageURL:        memo.ImageURL,
-		Rating:          memo.Rating,
-		IsPinned:        memo.IsPinned,
-		Latitude:        memo.Latitude,
-		Longitude:       memo.Longitude,
-		LocationName:    memo.LocationName,
-		Category:        memo.Category,
-		IsWishlist:      memo.IsWishlist,
-		BusinessName:    memo.BusinessName,
-		BusinessPhone:   memo.BusinessPhone,
-		BusinessAddress: memo.BusinessAddress,
-		NaverPlaceURL:   memo.NaverPlaceURL,
-		Comments:        comments,
-		CreatedAt:       memo.CreatedAt,
-		UpdatedAt:       memo.UpdatedAt,
+		ID:                memo.ID,
+		UserID:            memo.UserID,
+		RoomID:            memo.RoomID,
+		Title:             memo.Title,
+		Content:           memo.Content,
+		ImageURL:          imageURL,
+		ImageMediumURL:    imageMediumURL,
+		ImageThumbnailURL: imageThumbnailURL,
+		Rating:            memo.Rating,
+		IsPinned:          memo.IsPinned,
+		Latitude:          memo.Latitude,
+		Longitude:         memo.Longitude,
+		LocationName:      memo.LocationName,
+		Category:          memo.Category,
+		VisitedAt:         memo.VisitedAt,
+		IsWishlist:        memo.IsWishlist,
+		BusinessName:      memo.BusinessName,
+		BusinessPhone:     memo.BusinessPhone,
+		BusinessAddress:   memo.BusinessAddress,
+		NaverPlaceURL:     memo.NaverPlaceURL,
+		Version:           memo.Version,
+		Distance:          memo.Distance,
+		Tags:              tags,
+		Images:            images,
+		Comments:          comments,
+		CreatedAt:         memo.CreatedAt,
+		UpdatedAt:         memo.UpdatedAt,
+	}
+}
+
+// memoDBError 메모 조회/변경 중 발생한 DB 에러를 공통 에러 형식으로 변환
+func memoDBError(ctx context.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "memo not found", common.ErrFromClient)
+	}
+	return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+}
+
+// checkMemoVersion If-Match로 받은 버전과 현재 메모 버전 비교 (version이 0이면 If-Match: *로 보고 비교하지 않음)
+func checkMemoVersion(ctx context.Context, memo *mysql.Memo, version uint, store storage.ObjectStorage) error {
+	if version == 0 || memo.Version == version {
+		return nil
+	}
+	return newMemoConflict(ctx, memo, store)
+}
+
+// memoVersionDBError 버전 조건부 변경에서 발생한 DB 에러 변환
+// 변경된 행이 없으면 그 사이 다른 요청이 먼저 수정한 것이므로 현재 메모를 다시 조회해 412 (그 사이 삭제됐으면 404)
+func memoVersionDBError(ctx context.Context, err error, getByID func(context.Context, uint) (*mysql.Memo, error), memoID uint, store storage.ObjectStorage) error {
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return memoDBError(ctx, err)
+	}
+
+	current, err := getByID(ctx, memoID)
+	if err != nil {
+		return memoDBError(ctx, err)
+	}
+	return newMemoConflict(ctx, current, store)
+}
+
+// newMemoConflict 버전 불일치 에러 생성 (응답에 현재 메모 포함)
+func newMemoConflict(ctx context.Context, current *mysql.Memo, store storage.ObjectStorage) error {
+	msg := "memo has been modified by another request"
+	err := common.ErrorMsg(ctx, common.ErrPreconditionFailed, common.Trace(), msg, common.ErrFromClient)
+	return response.NewMemoConflict(string(common.ErrPreconditionFailed), msg, err, convertMemoToResponse(current, store))
+}
+
+// roomMemberDBError 방 멤버 조회 에러를 공통 에러 형식으로 변환 (멤버가 아니면 ErrRoomUserNotFound)
+func roomMemberDBError(ctx context.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.ErrorMsg(ctx, common.ErrRoomUserNotFound, common.Trace(), "user does not belong to the room", common.ErrFromClient)
+	}
+	return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+}
+
+// checkEditPermission 메모 작성/수정 권한 확인 (owner, editor만 가능)
+func checkEditPermission(ctx context.Context, member *mysql.RoomMember) error {
+	if !member.Role.CanEdit() {
+		return common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "viewer cannot create or edit memos", common.ErrFromClient)
+	}
+	return nil
+}
+
+// checkDeletePermission 메모 삭제 권한 확인 (작성자 또는 방 소유자만 가능)
+func checkDeletePermission(ctx context.Context, memo *mysql.Memo, member *mysql.RoomMember) error {
+	if memo.UserID != member.UserID && member.Role != mysql.RoomRoleOwner {
+		return common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "only the author or the room owner can delete the memo", common.ErrFromClient)
+	}
+	return nil
+}
+
+// isValidCoordinate 위도/경도 범위 확인
+func isValidCoordinate(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// memoGeohash 위도/경도가 모두 있으면 geohash 계산 (위치 인덱스용)
+func memoGeohash(lat, lng *float64) *string {
+	if lat == nil || lng == nil || !isValidCoordinate(*lat, *lng) {
+		return nil
 	}
+	geohash := common.EncodeGeohash(*lat, *lng, common.GeohashMaxPrecision)
+	return &geohash
 }
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"main/common"
 	_interface "main/features/profile/model/interface"
 	"net/http"
 
@@ -11,11 +12,11 @@ type GetProfileHandler struct {
 	UseCase _interface.IGetProfileUseCase
 }
 
-func NewGetProfileHandler(c *echo.Echo, useCase _interface.IGetProfileUseCase) _interface.IGetProfileHandler {
+func NewGetProfileHandler(c *echo.Group, useCase _interface.IGetProfileUseCase) _interface.IGetProfileHandler {
 	handler := &GetProfileHandler{
 		UseCase: useCase,
 	}
-	c.GET("/v0.1/profile", handler.GetProfile)
+	c.GET("/profile", handler.GetProfile)
 	return handler
 }
 
@@ -30,10 +31,8 @@ func NewGetProfileHandler(c *echo.Echo, useCase _interface.IGetProfileUseCase) _
 // @Failure 500 {object} map[string]interface{}
 // @Tags profile
 func (h *GetProfileHandler) GetProfile(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
 	profile, err := h.UseCase.GetProfile(ctx, userID)
 	if err != nil {
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"main/common"
 	"main/common/db/mysql"
+	"main/common/storage"
 	"main/features/profile/repository"
 	"main/features/profile/usecase"
 	"time"
@@ -9,7 +11,7 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-func NewProfileHandlers(e *echo.Echo) {
+func NewProfileHandlers(e *echo.Group) {
 	timeout := 30 * time.Second
 
 	// GetProfile
@@ -19,6 +21,6 @@ func NewProfileHandlers(e *echo.Echo) {
 
 	// UpdateProfile
 	updateProfileRepo := repository.NewUpdateProfileRepository(mysql.GormMysqlDB)
-	updateProfileUseCase := usecase.NewUpdateProfileUseCase(updateProfileRepo, timeout)
+	updateProfileUseCase := usecase.NewUpdateProfileUseCase(updateProfileRepo, common.NewBcryptHasher(), storage.Default, timeout)
 	NewUpdateProfileHandler(e, updateProfileUseCase)
 }
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"main/common"
 	_interface "main/features/profile/model/interface"
 	"main/features/profile/model/request"
 	"net/http"
@@ -12,11 +13,12 @@ type UpdateProfileHandler struct {
 	UseCase _interface.IUpdateProfileUseCase
 }
 
-func NewUpdateProfileHandler(c *echo.Echo, useCase _interface.IUpdateProfileUseCase) _interface.IUpdateProfileHandler {
+func NewUpdateProfileHandler(c *echo.Group, useCase _interface.IUpdateProfileUseCase) _interface.IUpdateProfileHandler {
 	handler := &UpdateProfileHandler{
 		UseCase: useCase,
 	}
-	c.PUT("/v0.1/profile", handler.UpdateProfile)
+	c.PUT("/profile", handler.UpdateProfile)
+	c.PUT("/profile/image", handler.UpdateProfileImage)
 	return handler
 }
 
@@ -24,6 +26,7 @@ func NewUpdateProfileHandler(c *echo.Echo, useCase _interface.IUpdateProfileUseC
 // @Router /v0.1/profile [put]
 // @Summary 프로필 업데이트 API
 // @Description 사용자의 프로필 정보를 업데이트합니다
+// @Description profile_image_url은 외부 이미지 URL만 지정할 수 있습니다 (이 서버의 이미지는 프로필 이미지 업로드 API 사용)
 // @Accept json
 // @Produce json
 // @Param req body request.ReqUpdateProfile true "Update Profile Request"
@@ -33,10 +36,8 @@ func NewUpdateProfileHandler(c *echo.Echo, useCase _interface.IUpdateProfileUseC
 // @Failure 500 {object} map[string]interface{}
 // @Tags profile
 func (h *UpdateProfileHandler) UpdateProfile(c echo.Context) error {
-	ctx := c.Request().Context()
-
-	// TODO: JWT에서 userID 추출
-	userID := uint(1)
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
 
 	// 요청 바인딩
 	var req request.ReqUpdateProfile
@@ -56,6 +57,10 @@ func (h *UpdateProfileHandler) UpdateProfile(c echo.Context) error {
 		if err.Error() == "incorrect current password" {
 			return c.JSON(http.StatusBadRequest, map[string]string{"error": "incorrect current password"})
 		}
+		// 이 저장소의 URL은 프로필 이미지 업로드 API로만 설정 가능
+		if err.Error() == "profile_image_url must be an external URL" {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "profile_image_url must be an external URL (use PUT /v0.1/profile/image to upload)"})
+		}
 		// 사용자 없음 에러
 		if err.Error() == "user not found" {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "user not found"})
@@ -66,3 +71,42 @@ func (h *UpdateProfileHandler) UpdateProfile(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, profile)
 }
+
+// UpdateProfileImage 프로필 이미지 업로드 API
+// @Router /v0.1/profile/image [put]
+// @Summary 프로필 이미지 업로드 API
+// @Description 프로필 이미지를 업로드하고 프로필에 적용합니다 (JPEG/PNG/WebP)
+// @Accept multipart/form-data
+// @Produce json
+// @Param image formData file true "프로필 이미지 파일"
+// @Success 200 {object} response.ResProfile
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags profile
+func (h *UpdateProfileHandler) UpdateProfileImage(c echo.Context) error {
+	// JWT에서 userID 추출 (TokenChecker 미들웨어에서 설정)
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "image is required"})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to open image file"})
+	}
+	defer file.Close()
+
+	profile, err := h.UseCase.UpdateProfileImage(ctx, userID, file)
+	if err != nil {
+		// 사용자 없음 에러
+		if err.Error() == "user not found" {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "user not found"})
+		}
+		return err
+	}
+
+	return c.JSON(http.StatusOK, profile)
+}
@@ -8,4 +8,5 @@ type IGetProfileHandler interface {
 
 type IUpdateProfileHandler interface {
 	UpdateProfile(c echo.Context) error
+	UpdateProfileImage(c echo.Context) error
 }
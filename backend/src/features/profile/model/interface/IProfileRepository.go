@@ -10,5 +10,7 @@ type IGetProfileRepository interface {
 }
 
 type IUpdateProfileRepository interface {
-	UpdateProfile(ctx context.Context, userID uint, currentPassword string, nickname *string, newPassword *string, profileImageURL *string) (*mysql.User, error)
+	GetByUserID(ctx context.Context, userID uint) (*mysql.User, error)
+	UpdateProfile(ctx context.Context, userID uint, nickname *string, hashedPassword *string, profileImageURL *string) (*mysql.User, error)
+	UpdateProfileImage(ctx context.Context, userID uint, profileImageURL string, key string, hasVariants bool) (*mysql.User, error)
 }
@@ -2,6 +2,7 @@ package _interface
 
 import (
 	"context"
+	"io"
 	"main/features/profile/model/request"
 	"main/features/profile/model/response"
 )
@@ -12,4 +13,5 @@ type IGetProfileUseCase interface {
 
 type IUpdateProfileUseCase interface {
 	UpdateProfile(ctx context.Context, userID uint, req request.ReqUpdateProfile) (*response.ResProfile, error)
+	UpdateProfileImage(ctx context.Context, userID uint, file io.Reader) (*response.ResProfile, error)
 }
@@ -5,5 +5,5 @@ type ReqUpdateProfile struct {
 	CurrentPassword string  `json:"current_password" binding:"required"`
 	Nickname        *string `json:"nickname"`
 	NewPassword     *string `json:"new_password"`
-	ProfileImageURL *string `json:"profile_image_url"`
+	ProfileImageURL *string `json:"profile_image_url"` // 외부 이미지 URL만 가능 (이 서버의 이미지는 업로드 API로 설정)
 }
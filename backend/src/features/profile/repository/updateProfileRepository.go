@@ -20,7 +20,27 @@ func NewUpdateProfileRepository(gormDB *gorm.DB) _interface.IUpdateProfileReposi
 	}
 }
 
-func (r *UpdateProfileRepository) UpdateProfile(ctx context.Context, userID uint, currentPassword string, nickname *string, newPassword *string, profileImageURL *string) (*mysql.User, error) {
+// GetByUserID 사용자 조회 (현재 비밀번호 검증용)
+func (r *UpdateProfileRepository) GetByUserID(ctx context.Context, userID uint) (*mysql.User, error) {
+	var user mysql.User
+
+	result := r.GormDB.WithContext(ctx).
+		Where("id = ?", userID).
+		First(&user)
+
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, result.Error
+	}
+
+	return &user, nil
+}
+
+// UpdateProfile 프로필 업데이트 (hashedPassword는 이미 해시된 값)
+// profileImageURL은 외부 URL이므로 서버가 저장한 프로필 이미지 키는 비움
+func (r *UpdateProfileRepository) UpdateProfile(ctx context.Context, userID uint, nickname *string, hashedPassword *string, profileImageURL *string) (*mysql.User, error) {
 	var user mysql.User
 
 	// 트랜잭션 시작
@@ -34,25 +54,22 @@ func (r *UpdateProfileRepository) UpdateProfile(ctx context.Context, userID uint
 			return result.Error
 		}
 
-		// 2. 현재 비밀번호 검증
-		if user.Password != currentPassword {
-			return fmt.Errorf("incorrect current password")
-		}
-
-		// 3. 필드 업데이트 (제공된 필드만)
+		// 2. 필드 업데이트 (제공된 필드만)
 		if nickname != nil {
 			user.Nickname = *nickname
 		}
 
-		if newPassword != nil {
-			user.Password = *newPassword
+		if hashedPassword != nil {
+			user.Password = *hashedPassword
 		}
 
 		if profileImageURL != nil {
 			user.ProfileImageURL = profileImageURL
+			user.ProfileImageKey = ""
+			user.ProfileImageHasVariants = false
 		}
 
-		// 4. 사용자 정보 저장
+		// 3. 사용자 정보 저장
 		if err := tx.Save(&user).Error; err != nil {
 			return err
 		}
@@ -66,3 +83,25 @@ func (r *UpdateProfileRepository) UpdateProfile(ctx context.Context, userID uint
 
 	return &user, nil
 }
+
+// UpdateProfileImage 서버가 저장한 이미지로 프로필 이미지 변경 (다음 교체 때 삭제할 객체 키도 함께 저장)
+func (r *UpdateProfileRepository) UpdateProfileImage(ctx context.Context, userID uint, profileImageURL string, key string, hasVariants bool) (*mysql.User, error) {
+	result := r.GormDB.WithContext(ctx).
+		Model(&mysql.User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"profile_image_url":          profileImageURL,
+			"profile_image_key":          key,
+			"profile_image_has_variants": hasVariants,
+		})
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	return r.GetByUserID(ctx, userID)
+}
@@ -2,6 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/storage"
 	_interface "main/features/profile/model/interface"
 	"main/features/profile/model/request"
 	"main/features/profile/model/response"
@@ -10,12 +16,16 @@ import (
 
 type UpdateProfileUseCase struct {
 	Repository     _interface.IUpdateProfileRepository
+	Hasher         common.PasswordHasher
+	Storage        storage.ObjectStorage
 	ContextTimeout time.Duration
 }
 
-func NewUpdateProfileUseCase(repo _interface.IUpdateProfileRepository, timeout time.Duration) _interface.IUpdateProfileUseCase {
+func NewUpdateProfileUseCase(repo _interface.IUpdateProfileRepository, hasher common.PasswordHasher, store storage.ObjectStorage, timeout time.Duration) _interface.IUpdateProfileUseCase {
 	return &UpdateProfileUseCase{
 		Repository:     repo,
+		Hasher:         hasher,
+		Storage:        store,
 		ContextTimeout: timeout,
 	}
 }
@@ -24,16 +34,97 @@ func (uc *UpdateProfileUseCase) UpdateProfile(ctx context.Context, userID uint,
 	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
 	defer cancel()
 
-	user, err := uc.Repository.UpdateProfile(ctx, userID, req.CurrentPassword, req.Nickname, req.NewPassword, req.ProfileImageURL)
+	user, err := uc.Repository.GetByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
+	// 현재 비밀번호 검증
+	match, needsRehash := uc.Hasher.Verify(user.Password, req.CurrentPassword)
+	if !match {
+		return nil, fmt.Errorf("incorrect current password")
+	}
+
+	// 새 비밀번호 해시 (새 비밀번호가 없고 레거시 평문이면 현재 비밀번호를 해시로 교체)
+	var hashedPassword *string
+	if req.NewPassword != nil || needsRehash {
+		password := req.CurrentPassword
+		if req.NewPassword != nil {
+			password = *req.NewPassword
+		}
+		hashed, err := uc.Hasher.Hash(password)
+		if err != nil {
+			return nil, fmt.Errorf("invalid new password: %w", err)
+		}
+		hashedPassword = &hashed
+	}
+
+	// 프로필 이미지 URL은 외부 URL만 직접 지정 가능 (이 저장소의 이미지는 업로드 API로만 설정, 현재 URL을 그대로 보내면 변경 없음)
+	var profileImageURL *string
+	if req.ProfileImageURL != nil && (user.ProfileImageURL == nil || *req.ProfileImageURL != *user.ProfileImageURL) {
+		if uc.Storage != nil && uc.Storage.KeyFromURL(*req.ProfileImageURL) != "" {
+			return nil, fmt.Errorf("profile_image_url must be an external URL")
+		}
+		profileImageURL = req.ProfileImageURL
+	}
+
+	updated, err := uc.Repository.UpdateProfile(ctx, userID, req.Nickname, hashedPassword, profileImageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// 외부 URL로 바꿨으면 이전에 서버가 저장한 프로필 이미지 삭제
+	if profileImageURL != nil {
+		uc.deletePreviousImage(ctx, user)
+	}
+
+	return convertProfileToResponse(updated), nil
+}
+
+// UpdateProfileImage 프로필 이미지 업로드 (중간 크기 이미지를 프로필 이미지로 사용, 이전 이미지 파일은 삭제)
+func (uc *UpdateProfileUseCase) UpdateProfileImage(ctx context.Context, userID uint, file io.Reader) (*response.ResProfile, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	user, err := uc.Repository.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded, err := storage.UploadImage(ctx, uc.Storage, file, storage.ProfileImageFolder)
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidImage) {
+			return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+		}
+		return nil, fmt.Errorf("failed to upload profile image: %w", err)
+	}
+
+	updated, err := uc.Repository.UpdateProfileImage(ctx, userID, uploaded.URLs[storage.ImageVariantMedium], uploaded.Key, true)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.deletePreviousImage(ctx, user)
+
+	return convertProfileToResponse(updated), nil
+}
+
+// deletePreviousImage 이전에 서버가 저장한 프로필 이미지 삭제 (profile_image_key만 삭제, 외부 URL이나 기존 데이터는 이미지 정리 작업에 맡김, 실패해도 무시)
+func (uc *UpdateProfileUseCase) deletePreviousImage(ctx context.Context, user *mysql.User) {
+	if user.ProfileImageKey == "" || uc.Storage == nil {
+		return
+	}
+	if err := storage.DeleteImageObjects(ctx, uc.Storage, user.ProfileImageKey, user.ProfileImageHasVariants); err != nil {
+		fmt.Printf("⚠️ 이전 프로필 이미지 삭제 실패: %v\n", err)
+	}
+}
+
+func convertProfileToResponse(user *mysql.User) *response.ResProfile {
 	return &response.ResProfile{
 		UserID:          user.ID,
 		AccountID:       user.AccountID,
 		Nickname:        user.Nickname,
 		ProfileImageURL: user.ProfileImageURL,
 		DefaultRoomID:   user.DefaultRoomID,
-	}, nil
+	}
 }
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreateRoomHandler struct {
+	UseCase _interface.ICreateRoomUseCase
+}
+
+func NewCreateRoomHandler(c *echo.Group, useCase _interface.ICreateRoomUseCase) _interface.ICreateRoomHandler {
+	handler := &CreateRoomHandler{
+		UseCase: useCase,
+	}
+	c.POST("/rooms", handler.CreateRoom)
+	return handler
+}
+
+// CreateRoom 방 생성 API
+// @Router /v0.1/rooms [post]
+// @Summary 방 생성 API
+// @Description 새로운 방을 생성합니다 (생성한 사용자가 소유자가 됩니다)
+// @Accept json
+// @Produce json
+// @Param request body request.ReqCreateRoom true "방 생성 요청 데이터"
+// @Success 201 {object} response.ResRoom
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *CreateRoomHandler) CreateRoom(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	var req request.ReqCreateRoom
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request body", common.ErrFromClient)
+	}
+
+	room, err := h.UseCase.CreateRoom(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, room)
+}
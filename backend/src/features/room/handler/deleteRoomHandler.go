@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/room/model/interface"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DeleteRoomHandler struct {
+	UseCase _interface.IDeleteRoomUseCase
+}
+
+func NewDeleteRoomHandler(c *echo.Group, useCase _interface.IDeleteRoomUseCase) _interface.IDeleteRoomHandler {
+	handler := &DeleteRoomHandler{
+		UseCase: useCase,
+	}
+	c.DELETE("/rooms/:id", handler.DeleteRoom)
+	return handler
+}
+
+// DeleteRoom 방 삭제 API
+// @Router /v0.1/rooms/{id} [delete]
+// @Summary 방 삭제 API
+// @Description 소유한 방을 삭제합니다 (방의 메모와 댓글도 함께 삭제, 기본 방은 삭제 불가)
+// @Param id path int true "방 ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *DeleteRoomHandler) DeleteRoom(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room id", common.ErrFromClient)
+	}
+
+	if err := h.UseCase.DeleteRoom(ctx, uint(id), userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
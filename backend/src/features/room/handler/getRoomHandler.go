@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/room/model/interface"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetRoomHandler struct {
+	UseCase _interface.IGetRoomUseCase
+}
+
+func NewGetRoomHandler(c *echo.Group, useCase _interface.IGetRoomUseCase) _interface.IGetRoomHandler {
+	handler := &GetRoomHandler{
+		UseCase: useCase,
+	}
+	c.GET("/rooms", handler.GetRoomList)
+	return handler
+}
+
+// GetRoomList 방 목록 조회 API
+// @Router /v0.1/rooms [get]
+// @Summary 방 목록 조회 API
+// @Description 현재 사용자가 속한 방 목록을 조회합니다
+// @Produce json
+// @Success 200 {object} response.ResRoomList
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *GetRoomHandler) GetRoomList(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	rooms, err := h.UseCase.GetRoomList(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rooms)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"main/common/db/mysql"
+	"main/features/room/repository"
+	"main/features/room/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func NewRoomHandlers(e *echo.Group) {
+	timeout := 30 * time.Second
+
+	// Create
+	createRepo := repository.NewCreateRoomRepository(mysql.GormMysqlDB)
+	createUseCase := usecase.NewCreateRoomUseCase(createRepo, timeout)
+	NewCreateRoomHandler(e, createUseCase)
+
+	// Get
+	getRepo := repository.NewGetRoomRepository(mysql.GormMysqlDB)
+	getUseCase := usecase.NewGetRoomUseCase(getRepo, timeout)
+	NewGetRoomHandler(e, getUseCase)
+
+	// Update
+	updateRepo := repository.NewUpdateRoomRepository(mysql.GormMysqlDB)
+	updateUseCase := usecase.NewUpdateRoomUseCase(updateRepo, timeout)
+	NewUpdateRoomHandler(e, updateUseCase)
+
+	// Delete
+	deleteRepo := repository.NewDeleteRoomRepository(mysql.GormMysqlDB)
+	deleteUseCase := usecase.NewDeleteRoomUseCase(deleteRepo, timeout)
+	NewDeleteRoomHandler(e, deleteUseCase)
+
+	// Join / Invite
+	joinRepo := repository.NewJoinRoomRepository(mysql.GormMysqlDB)
+	joinUseCase := usecase.NewJoinRoomUseCase(joinRepo, timeout)
+	NewJoinRoomHandler(e, joinUseCase)
+
+	// Member
+	memberRepo := repository.NewRoomMemberRepository(mysql.GormMysqlDB)
+	memberUseCase := usecase.NewRoomMemberUseCase(memberRepo, timeout)
+	NewRoomMemberHandler(e, memberUseCase)
+}
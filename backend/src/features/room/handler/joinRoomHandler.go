@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/request"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type JoinRoomHandler struct {
+	UseCase _interface.IJoinRoomUseCase
+}
+
+func NewJoinRoomHandler(c *echo.Group, useCase _interface.IJoinRoomUseCase) _interface.IJoinRoomHandler {
+	handler := &JoinRoomHandler{
+		UseCase: useCase,
+	}
+	c.POST("/rooms/join", handler.JoinRoom)
+	c.POST("/rooms/:id/invites", handler.CreateInvite)
+	return handler
+}
+
+// JoinRoom 방 참여 API
+// @Router /v0.1/rooms/join [post]
+// @Summary 방 참여 API
+// @Description 초대 코드 또는 방 고유 코드로 방에 참여합니다 (이미 참여한 방이면 방 정보를 그대로 반환)
+// @Description 강퇴된 사용자는 소유자가 강퇴를 취소할 때까지 참여할 수 없습니다 (403)
+// @Accept json
+// @Produce json
+// @Param request body request.ReqJoinRoom true "방 참여 요청 데이터"
+// @Success 200 {object} response.ResRoom
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *JoinRoomHandler) JoinRoom(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	var req request.ReqJoinRoom
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request body", common.ErrFromClient)
+	}
+
+	room, err := h.UseCase.JoinRoom(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, room)
+}
+
+// CreateInvite 방 초대 코드 생성 API
+// @Router /v0.1/rooms/{id}/invites [post]
+// @Summary 방 초대 코드 생성 API
+// @Description 만료 시간이 있는 초대 코드를 생성합니다 (소유자만 가능, role: editor/viewer, 기본 editor / 24시간)
+// @Accept json
+// @Produce json
+// @Param id path int true "방 ID"
+// @Param request body request.ReqCreateInvite true "초대 코드 생성 요청 데이터"
+// @Success 201 {object} response.ResRoomInvite
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *JoinRoomHandler) CreateInvite(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room id", common.ErrFromClient)
+	}
+
+	var req request.ReqCreateInvite
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request body", common.ErrFromClient)
+	}
+
+	invite, err := h.UseCase.CreateInvite(ctx, uint(id), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, invite)
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/room/model/interface"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type RoomMemberHandler struct {
+	UseCase _interface.IRoomMemberUseCase
+}
+
+func NewRoomMemberHandler(c *echo.Group, useCase _interface.IRoomMemberUseCase) _interface.IRoomMemberHandler {
+	handler := &RoomMemberHandler{
+		UseCase: useCase,
+	}
+	c.GET("/rooms/:id/members", handler.GetMembers)
+	c.DELETE("/rooms/:id/members/:user_id", handler.KickMember)
+	c.DELETE("/rooms/:id/kicks/:user_id", handler.CancelKick)
+	c.POST("/rooms/:id/leave", handler.LeaveRoom)
+	return handler
+}
+
+// GetMembers 방 멤버 목록 조회 API
+// @Router /v0.1/rooms/{id}/members [get]
+// @Summary 방 멤버 목록 조회 API
+// @Description 방에 참여한 멤버와 권한을 조회합니다 (방 멤버만 가능)
+// @Produce json
+// @Param id path int true "방 ID"
+// @Success 200 {object} response.ResRoomMemberList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *RoomMemberHandler) GetMembers(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room id", common.ErrFromClient)
+	}
+
+	members, err := h.UseCase.GetMembers(ctx, uint(id), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, members)
+}
+
+// KickMember 방 멤버 강퇴 API
+// @Router /v0.1/rooms/{id}/members/{user_id} [delete]
+// @Summary 방 멤버 강퇴 API
+// @Description 방 멤버를 강퇴합니다 (소유자만 가능, 강퇴된 사용자는 강퇴를 취소할 때까지 다시 참여할 수 없음)
+// @Param id path int true "방 ID"
+// @Param user_id path int true "강퇴할 사용자 ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *RoomMemberHandler) KickMember(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room id", common.ErrFromClient)
+	}
+
+	targetUserID, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid user id", common.ErrFromClient)
+	}
+
+	if err := h.UseCase.KickMember(ctx, uint(id), userID, uint(targetUserID)); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// CancelKick 강퇴 취소 API
+// @Router /v0.1/rooms/{id}/kicks/{user_id} [delete]
+// @Summary 강퇴 취소 API
+// @Description 강퇴 기록을 삭제해 다시 참여할 수 있게 합니다 (소유자만 가능)
+// @Param id path int true "방 ID"
+// @Param user_id path int true "강퇴를 취소할 사용자 ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *RoomMemberHandler) CancelKick(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room id", common.ErrFromClient)
+	}
+
+	targetUserID, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid user id", common.ErrFromClient)
+	}
+
+	if err := h.UseCase.CancelKick(ctx, uint(id), userID, uint(targetUserID)); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// LeaveRoom 방 나가기 API
+// @Router /v0.1/rooms/{id}/leave [post]
+// @Summary 방 나가기 API
+// @Description 참여한 방에서 나갑니다 (소유자는 나갈 수 없음)
+// @Param id path int true "방 ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *RoomMemberHandler) LeaveRoom(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room id", common.ErrFromClient)
+	}
+
+	if err := h.UseCase.LeaveRoom(ctx, uint(id), userID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/request"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type UpdateRoomHandler struct {
+	UseCase _interface.IUpdateRoomUseCase
+}
+
+func NewUpdateRoomHandler(c *echo.Group, useCase _interface.IUpdateRoomUseCase) _interface.IUpdateRoomHandler {
+	handler := &UpdateRoomHandler{
+		UseCase: useCase,
+	}
+	c.PUT("/rooms/default", handler.SetDefaultRoom)
+	c.PUT("/rooms/:id", handler.UpdateRoom)
+	return handler
+}
+
+// UpdateRoom 방 이름 변경 API
+// @Router /v0.1/rooms/{id} [put]
+// @Summary 방 이름 변경 API
+// @Description 방 이름을 변경합니다 (소유자만 가능)
+// @Accept json
+// @Produce json
+// @Param id path int true "방 ID"
+// @Param request body request.ReqUpdateRoom true "방 이름 변경 요청 데이터"
+// @Success 200 {object} response.ResRoom
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *UpdateRoomHandler) UpdateRoom(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room id", common.ErrFromClient)
+	}
+
+	var req request.ReqUpdateRoom
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request body", common.ErrFromClient)
+	}
+
+	room, err := h.UseCase.UpdateRoom(ctx, uint(id), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, room)
+}
+
+// SetDefaultRoom 기본 방 변경 API
+// @Router /v0.1/rooms/default [put]
+// @Summary 기본 방 변경 API
+// @Description 현재 사용자의 기본 방을 변경합니다
+// @Accept json
+// @Param request body request.ReqSetDefaultRoom true "기본 방 변경 요청 데이터"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags room
+func (h *UpdateRoomHandler) SetDefaultRoom(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	var req request.ReqSetDefaultRoom
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request body", common.ErrFromClient)
+	}
+
+	if err := h.UseCase.SetDefaultRoom(ctx, userID, req); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
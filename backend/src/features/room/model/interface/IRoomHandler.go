@@ -0,0 +1,32 @@
+package _interface
+
+import "github.com/labstack/echo/v4"
+
+type ICreateRoomHandler interface {
+	CreateRoom(c echo.Context) error
+}
+
+type IGetRoomHandler interface {
+	GetRoomList(c echo.Context) error
+}
+
+type IUpdateRoomHandler interface {
+	UpdateRoom(c echo.Context) error
+	SetDefaultRoom(c echo.Context) error
+}
+
+type IDeleteRoomHandler interface {
+	DeleteRoom(c echo.Context) error
+}
+
+type IJoinRoomHandler interface {
+	JoinRoom(c echo.Context) error
+	CreateInvite(c echo.Context) error
+}
+
+type IRoomMemberHandler interface {
+	GetMembers(c echo.Context) error
+	KickMember(c echo.Context) error
+	CancelKick(c echo.Context) error
+	LeaveRoom(c echo.Context) error
+}
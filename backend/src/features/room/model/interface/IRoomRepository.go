@@ -0,0 +1,47 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+)
+
+type ICreateRoomRepository interface {
+	Create(ctx context.Context, room *mysql.Room) error
+}
+
+type IGetRoomRepository interface {
+	GetListByUserID(ctx context.Context, userID uint) ([]mysql.RoomMember, error)
+	GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error)
+}
+
+type IUpdateRoomRepository interface {
+	GetByID(ctx context.Context, id uint) (*mysql.Room, error)
+	GetMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error)
+	UpdateName(ctx context.Context, id uint, name string) error
+	SetDefaultRoom(ctx context.Context, userID uint, roomID uint) error
+}
+
+type IDeleteRoomRepository interface {
+	GetByID(ctx context.Context, id uint) (*mysql.Room, error)
+	GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error)
+	DeleteWithCascade(ctx context.Context, id uint) error
+}
+
+type IJoinRoomRepository interface {
+	GetByID(ctx context.Context, id uint) (*mysql.Room, error)
+	GetByRoomCode(ctx context.Context, roomCode string) (*mysql.Room, error)
+	GetInviteByCode(ctx context.Context, code string) (*mysql.RoomInvite, error)
+	GetMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	IsKicked(ctx context.Context, roomID uint, userID uint) (bool, error)
+	AddMember(ctx context.Context, member *mysql.RoomMember) error
+	CreateInvite(ctx context.Context, invite *mysql.RoomInvite) error
+}
+
+type IRoomMemberRepository interface {
+	GetMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	GetMembers(ctx context.Context, roomID uint) ([]mysql.RoomMember, error)
+	RemoveMember(ctx context.Context, roomID uint, userID uint) error
+	KickMember(ctx context.Context, roomID uint, userID uint, kickedByUserID uint) error
+	DeleteKick(ctx context.Context, roomID uint, userID uint) error
+}
@@ -0,0 +1,36 @@
+package _interface
+
+import (
+	"context"
+	"main/features/room/model/request"
+	"main/features/room/model/response"
+)
+
+type ICreateRoomUseCase interface {
+	CreateRoom(ctx context.Context, userID uint, req request.ReqCreateRoom) (*response.ResRoom, error)
+}
+
+type IGetRoomUseCase interface {
+	GetRoomList(ctx context.Context, userID uint) (*response.ResRoomList, error)
+}
+
+type IUpdateRoomUseCase interface {
+	UpdateRoom(ctx context.Context, roomID uint, userID uint, req request.ReqUpdateRoom) (*response.ResRoom, error)
+	SetDefaultRoom(ctx context.Context, userID uint, req request.ReqSetDefaultRoom) error
+}
+
+type IDeleteRoomUseCase interface {
+	DeleteRoom(ctx context.Context, roomID uint, userID uint) error
+}
+
+type IJoinRoomUseCase interface {
+	JoinRoom(ctx context.Context, userID uint, req request.ReqJoinRoom) (*response.ResRoom, error)
+	CreateInvite(ctx context.Context, roomID uint, userID uint, req request.ReqCreateInvite) (*response.ResRoomInvite, error)
+}
+
+type IRoomMemberUseCase interface {
+	GetMembers(ctx context.Context, roomID uint, userID uint) (*response.ResRoomMemberList, error)
+	KickMember(ctx context.Context, roomID uint, userID uint, targetUserID uint) error
+	CancelKick(ctx context.Context, roomID uint, userID uint, targetUserID uint) error
+	LeaveRoom(ctx context.Context, roomID uint, userID uint) error
+}
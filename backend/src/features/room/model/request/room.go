@@ -0,0 +1,40 @@
+package request
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type ReqCreateRoom struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type ReqUpdateRoom struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type ReqSetDefaultRoom struct {
+	RoomID uint `json:"room_id" binding:"required"`
+}
+
+// ValidateRoomName 방 이름 검증 (앞뒤 공백 제거 후 1~100자)
+func ValidateRoomName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("name is required")
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return "", fmt.Errorf("name exceeds maximum length of 100 characters")
+	}
+	return name, nil
+}
+
+type ReqJoinRoom struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type ReqCreateInvite struct {
+	Role             string `json:"role"`
+	ExpiresInMinutes int    `json:"expires_in_minutes"`
+}
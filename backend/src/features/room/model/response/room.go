@@ -0,0 +1,40 @@
+package response
+
+import "time"
+
+type ResRoom struct {
+	ID          uint      `json:"id"`
+	RoomCode    string    `json:"room_code,omitempty"` // 소유자에게만 내려줌
+	Name        string    `json:"name"`
+	OwnerUserID uint      `json:"owner_user_id"`
+	IsOwner     bool      `json:"is_owner"`
+	Role        string    `json:"role"`
+	IsDefault   bool      `json:"is_default"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ResRoomList struct {
+	Rooms []ResRoom `json:"rooms"`
+	Total int64     `json:"total"`
+}
+
+type ResRoomMember struct {
+	UserID    uint      `json:"user_id"`
+	AccountID string    `json:"account_id"`
+	Nickname  string    `json:"nickname"`
+	Role      string    `json:"role"`
+	JoinedAt  time.Time `json:"joined_at"`
+}
+
+type ResRoomMemberList struct {
+	Members []ResRoomMember `json:"members"`
+	Total   int64           `json:"total"`
+}
+
+type ResRoomInvite struct {
+	Code      string    `json:"code"`
+	RoomID    uint      `json:"room_id"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+
+	"gorm.io/gorm"
+)
+
+type CreateRoomRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewCreateRoomRepository(gormDB *gorm.DB) _interface.ICreateRoomRepository {
+	return &CreateRoomRepository{
+		GormDB: gormDB,
+	}
+}
+
+// Create 방 생성 (생성자를 owner 멤버로 함께 등록)
+func (r *CreateRoomRepository) Create(ctx context.Context, room *mysql.Room) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(room).Error; err != nil {
+			return err
+		}
+
+		member := &mysql.RoomMember{
+			RoomID: room.ID,
+			UserID: room.OwnerUserID,
+			Role:   mysql.RoomRoleOwner,
+		}
+		return tx.Create(member).Error
+	})
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+
+	"gorm.io/gorm"
+)
+
+type DeleteRoomRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewDeleteRoomRepository(gormDB *gorm.DB) _interface.IDeleteRoomRepository {
+	return &DeleteRoomRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetByID 특정 방 조회
+func (r *DeleteRoomRepository) GetByID(ctx context.Context, id uint) (*mysql.Room, error) {
+	var room mysql.Room
+	result := r.GormDB.WithContext(ctx).
+		Where("id = ?", id).
+		First(&room)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &room, nil
+}
+
+// GetDefaultRoomID 사용자의 기본 방 ID 조회
+func (r *DeleteRoomRepository) GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error) {
+	var user mysql.User
+	result := r.GormDB.WithContext(ctx).
+		Select("id", "default_room_id").
+		Where("id = ?", userID).
+		First(&user)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return user.DefaultRoomID, nil
+}
+
+// DeleteWithCascade 방 삭제 (Soft Delete) - 방에 속한 메모, 댓글, 멤버, 초대 코드도 함께 삭제
+func (r *DeleteRoomRepository) DeleteWithCascade(ctx context.Context, id uint) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 방에 속한 메모들의 댓글 삭제
+		if err := tx.Where("memo_id IN (?)", tx.Model(&mysql.Memo{}).Select("id").Where("room_id = ?", id)).
+			Delete(&mysql.Comment{}).Error; err != nil {
+			return err
+		}
+
+		// 2. 방에 속한 메모 삭제
+		if err := tx.Where("room_id = ?", id).Delete(&mysql.Memo{}).Error; err != nil {
+			return err
+		}
+
+		// 3. 이 방을 기본 방으로 쓰던 멤버는 본인이 소유한 다른 방으로 기본 방 변경
+		if err := tx.Exec("UPDATE users SET default_room_id = (SELECT r.id FROM rooms r WHERE r.owner_user_id = users.id AND r.deleted_at IS NULL AND r.id <> ? ORDER BY r.id LIMIT 1) WHERE default_room_id = ?", id, id).Error; err != nil {
+			return err
+		}
+
+		// 4. 멤버 및 초대 코드 삭제
+		if err := tx.Where("room_id = ?", id).Delete(&mysql.RoomMember{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("room_id = ?", id).Delete(&mysql.RoomInvite{}).Error; err != nil {
+			return err
+		}
+
+		// 5. 방 삭제
+		result := tx.Where("id = ?", id).Delete(&mysql.Room{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return nil
+	})
+}
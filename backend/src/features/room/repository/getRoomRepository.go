@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+
+	"gorm.io/gorm"
+)
+
+type GetRoomRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewGetRoomRepository(gormDB *gorm.DB) _interface.IGetRoomRepository {
+	return &GetRoomRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetListByUserID 사용자가 멤버인 방 목록 조회 (권한 포함)
+func (r *GetRoomRepository) GetListByUserID(ctx context.Context, userID uint) ([]mysql.RoomMember, error) {
+	var members []mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		InnerJoins("Room").
+		Where("room_members.user_id = ?", userID).
+		Order("room_members.created_at ASC").
+		Find(&members)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return members, nil
+}
+
+// GetDefaultRoomID 사용자의 기본 방 ID 조회
+func (r *GetRoomRepository) GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error) {
+	var user mysql.User
+	result := r.GormDB.WithContext(ctx).
+		Select("id", "default_room_id").
+		Where("id = ?", userID).
+		First(&user)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return user.DefaultRoomID, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// mysqlDuplicateEntry MySQL 유니크 키 중복 에러 번호 (ER_DUP_ENTRY)
+const mysqlDuplicateEntry = 1062
+
+type JoinRoomRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewJoinRoomRepository(gormDB *gorm.DB) _interface.IJoinRoomRepository {
+	return &JoinRoomRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetByID 특정 방 조회
+func (r *JoinRoomRepository) GetByID(ctx context.Context, id uint) (*mysql.Room, error) {
+	var room mysql.Room
+	result := r.GormDB.WithContext(ctx).
+		Where("id = ?", id).
+		First(&room)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &room, nil
+}
+
+// GetByRoomCode 방 고유 코드(UUID)로 방 조회
+func (r *JoinRoomRepository) GetByRoomCode(ctx context.Context, roomCode string) (*mysql.Room, error) {
+	var room mysql.Room
+	result := r.GormDB.WithContext(ctx).
+		Where("room_code = ?", roomCode).
+		First(&room)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &room, nil
+}
+
+// GetInviteByCode 초대 코드 조회
+func (r *JoinRoomRepository) GetInviteByCode(ctx context.Context, code string) (*mysql.RoomInvite, error) {
+	var invite mysql.RoomInvite
+	result := r.GormDB.WithContext(ctx).
+		Where("code = ?", code).
+		First(&invite)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &invite, nil
+}
+
+// GetMember 방 멤버 조회
+func (r *JoinRoomRepository) GetMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// IsKicked 방에서 강퇴된 사용자인지 확인
+func (r *JoinRoomRepository) IsKicked(ctx context.Context, roomID uint, userID uint) (bool, error) {
+	var count int64
+	result := r.GormDB.WithContext(ctx).
+		Model(&mysql.RoomKick{}).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+// AddMember 방 멤버 추가 (동시 참여 요청으로 이미 멤버가 된 경우 gorm.ErrDuplicatedKey)
+func (r *JoinRoomRepository) AddMember(ctx context.Context, member *mysql.RoomMember) error {
+	result := r.GormDB.WithContext(ctx).Create(member)
+
+	var mysqlErr *mysqlDriver.MySQLError
+	if errors.As(result.Error, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+		return gorm.ErrDuplicatedKey
+	}
+
+	return result.Error
+}
+
+// CreateInvite 초대 코드 생성
+func (r *JoinRoomRepository) CreateInvite(ctx context.Context, invite *mysql.RoomInvite) error {
+	result := r.GormDB.WithContext(ctx).Create(invite)
+	return result.Error
+}
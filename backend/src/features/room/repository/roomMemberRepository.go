@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RoomMemberRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewRoomMemberRepository(gormDB *gorm.DB) _interface.IRoomMemberRepository {
+	return &RoomMemberRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetMember 방 멤버 조회
+func (r *RoomMemberRepository) GetMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetMembers 방 멤버 목록 조회 (사용자 정보 포함)
+func (r *RoomMemberRepository) GetMembers(ctx context.Context, roomID uint) ([]mysql.RoomMember, error) {
+	var members []mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Preload("User").
+		Where("room_id = ?", roomID).
+		Order("created_at ASC").
+		Find(&members)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return members, nil
+}
+
+// RemoveMember 방 멤버 제거 (탈퇴) - 이 방이 기본 방이었다면 본인이 소유한 방으로 변경
+func (r *RoomMemberRepository) RemoveMember(ctx context.Context, roomID uint, userID uint) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return removeMember(tx, roomID, userID)
+	})
+}
+
+// KickMember 방 멤버 강퇴 - 멤버 제거와 강퇴 기록을 한 트랜잭션으로 처리
+func (r *RoomMemberRepository) KickMember(ctx context.Context, roomID uint, userID uint, kickedByUserID uint) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := removeMember(tx, roomID, userID); err != nil {
+			return err
+		}
+
+		kick := &mysql.RoomKick{
+			RoomID:         roomID,
+			UserID:         userID,
+			KickedByUserID: kickedByUserID,
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(kick).Error
+	})
+}
+
+// DeleteKick 강퇴 기록 삭제 (강퇴 취소, 기록이 없으면 ErrRecordNotFound)
+func (r *RoomMemberRepository) DeleteKick(ctx context.Context, roomID uint, userID uint) error {
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		Delete(&mysql.RoomKick{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// removeMember 방 멤버 삭제 - 이 방이 기본 방이었다면 본인이 소유한 방으로 변경 (멤버가 아니면 ErrRecordNotFound)
+func removeMember(tx *gorm.DB, roomID uint, userID uint) error {
+	result := tx.Where("room_id = ? AND user_id = ?", roomID, userID).Delete(&mysql.RoomMember{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return tx.Model(&mysql.User{}).
+		Where("id = ? AND default_room_id = ?", userID, roomID).
+		Update("default_room_id", gorm.Expr("(SELECT r.id FROM rooms r WHERE r.owner_user_id = ? AND r.deleted_at IS NULL ORDER BY r.id LIMIT 1)", userID)).
+		Error
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+
+	"gorm.io/gorm"
+)
+
+type UpdateRoomRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewUpdateRoomRepository(gormDB *gorm.DB) _interface.IUpdateRoomRepository {
+	return &UpdateRoomRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetByID 특정 방 조회
+func (r *UpdateRoomRepository) GetByID(ctx context.Context, id uint) (*mysql.Room, error) {
+	var room mysql.Room
+	result := r.GormDB.WithContext(ctx).
+		Where("id = ?", id).
+		First(&room)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &room, nil
+}
+
+// GetMember 방 멤버 조회
+func (r *UpdateRoomRepository) GetMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetDefaultRoomID 사용자의 기본 방 ID 조회
+func (r *UpdateRoomRepository) GetDefaultRoomID(ctx context.Context, userID uint) (*uint, error) {
+	var user mysql.User
+	result := r.GormDB.WithContext(ctx).
+		Select("id", "default_room_id").
+		Where("id = ?", userID).
+		First(&user)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return user.DefaultRoomID, nil
+}
+
+// UpdateName 방 이름 변경
+func (r *UpdateRoomRepository) UpdateName(ctx context.Context, id uint, name string) error {
+	result := r.GormDB.WithContext(ctx).
+		Model(&mysql.Room{}).
+		Where("id = ?", id).
+		Update("name", name)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// SetDefaultRoom 사용자의 기본 방 변경
+func (r *UpdateRoomRepository) SetDefaultRoom(ctx context.Context, userID uint, roomID uint) error {
+	result := r.GormDB.WithContext(ctx).
+		Model(&mysql.User{}).
+		Where("id = ?", userID).
+		Update("default_room_id", roomID)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/request"
+	"main/features/room/model/response"
+	"time"
+)
+
+type CreateRoomUseCase struct {
+	Repository     _interface.ICreateRoomRepository
+	ContextTimeout time.Duration
+}
+
+func NewCreateRoomUseCase(repo _interface.ICreateRoomRepository, timeout time.Duration) _interface.ICreateRoomUseCase {
+	return &CreateRoomUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// CreateRoom 방 생성 (생성한 사용자가 소유자)
+func (uc *CreateRoomUseCase) CreateRoom(ctx context.Context, userID uint, req request.ReqCreateRoom) (*response.ResRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	name, err := request.ValidateRoomName(req.Name)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	room := &mysql.Room{
+		RoomCode:    mysql.PKIDGenerate(),
+		Name:        name,
+		OwnerUserID: userID,
+	}
+
+	if err := uc.Repository.Create(ctx, room); err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	return convertRoomToResponse(room, userID, mysql.RoomRoleOwner, nil), nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"main/common"
+	_interface "main/features/room/model/interface"
+	"time"
+)
+
+type DeleteRoomUseCase struct {
+	Repository     _interface.IDeleteRoomRepository
+	ContextTimeout time.Duration
+}
+
+func NewDeleteRoomUseCase(repo _interface.IDeleteRoomRepository, timeout time.Duration) _interface.IDeleteRoomUseCase {
+	return &DeleteRoomUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// DeleteRoom 방 삭제 (소유자만 가능, 메모/댓글 함께 삭제)
+func (uc *DeleteRoomUseCase) DeleteRoom(ctx context.Context, roomID uint, userID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	room, err := uc.Repository.GetByID(ctx, roomID)
+	if err != nil {
+		return roomDBError(ctx, err)
+	}
+
+	if room.OwnerUserID != userID {
+		return common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "only the room owner can delete the room", common.ErrFromClient)
+	}
+
+	// 기본 방은 다른 방을 기본 방으로 지정한 뒤에만 삭제 가능
+	defaultRoomID, err := uc.Repository.GetDefaultRoomID(ctx, userID)
+	if err != nil {
+		return roomDBError(ctx, err)
+	}
+	if defaultRoomID != nil && *defaultRoomID == roomID {
+		return common.ErrorMsg(ctx, common.ErrBadRequest, common.Trace(), "cannot delete the default room, change the default room first", common.ErrFromClient)
+	}
+
+	if err := uc.Repository.DeleteWithCascade(ctx, roomID); err != nil {
+		return roomDBError(ctx, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/response"
+	"time"
+)
+
+type GetRoomUseCase struct {
+	Repository     _interface.IGetRoomRepository
+	ContextTimeout time.Duration
+}
+
+func NewGetRoomUseCase(repo _interface.IGetRoomRepository, timeout time.Duration) _interface.IGetRoomUseCase {
+	return &GetRoomUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// GetRoomList 사용자가 속한 방 목록 조회
+func (uc *GetRoomUseCase) GetRoomList(ctx context.Context, userID uint) (*response.ResRoomList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	defaultRoomID, err := uc.Repository.GetDefaultRoomID(ctx, userID)
+	if err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	members, err := uc.Repository.GetListByUserID(ctx, userID)
+	if err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	resRooms := make([]response.ResRoom, 0, len(members))
+	for _, member := range members {
+		if member.Room == nil {
+			continue
+		}
+		resRooms = append(resRooms, *convertRoomToResponse(member.Room, userID, member.Role, defaultRoomID))
+	}
+
+	return &response.ResRoomList{
+		Rooms: resRooms,
+		Total: int64(len(resRooms)),
+	}, nil
+}
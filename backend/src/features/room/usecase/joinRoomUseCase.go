@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/request"
+	"main/features/room/model/response"
+	"math/big"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	inviteCodeLength          = 8
+	inviteCodeAlphabet        = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // 혼동되는 문자(0/O, 1/I) 제외
+	defaultInviteExpireMinute = 60 * 24
+	maxInviteExpireMinute     = 60 * 24 * 30
+)
+
+type JoinRoomUseCase struct {
+	Repository     _interface.IJoinRoomRepository
+	ContextTimeout time.Duration
+}
+
+func NewJoinRoomUseCase(repo _interface.IJoinRoomRepository, timeout time.Duration) _interface.IJoinRoomUseCase {
+	return &JoinRoomUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// JoinRoom 초대 코드 또는 방 고유 코드로 방 참여 (이미 멤버이면 현재 방 정보 반환, 강퇴된 사용자는 참여 불가)
+func (uc *JoinRoomUseCase) JoinRoom(ctx context.Context, userID uint, req request.ReqJoinRoom) (*response.ResRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "code is required", common.ErrFromClient)
+	}
+
+	room, role, err := uc.resolveCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := uc.Repository.GetMember(ctx, room.ID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	// 이미 참여한 방이면 기존 권한 유지
+	if member == nil {
+		kicked, err := uc.Repository.IsKicked(ctx, room.ID, userID)
+		if err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+		if kicked {
+			return nil, common.ErrorMsg(ctx, common.ErrRoomUserKicked, common.Trace(), "user was kicked from the room", common.ErrFromClient)
+		}
+
+		member, err = uc.addMember(ctx, room.ID, userID, role)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return convertRoomToResponse(room, userID, member.Role, nil), nil
+}
+
+// CreateInvite 방 초대 코드 생성 (소유자만 가능)
+func (uc *JoinRoomUseCase) CreateInvite(ctx context.Context, roomID uint, userID uint, req request.ReqCreateInvite) (*response.ResRoomInvite, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	role := mysql.RoomRoleEditor
+	if req.Role != "" {
+		role = mysql.RoomRole(req.Role)
+	}
+	if !role.IsValid() || role == mysql.RoomRoleOwner {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "role must be editor or viewer", common.ErrFromClient)
+	}
+
+	expiresIn := req.ExpiresInMinutes
+	if expiresIn == 0 {
+		expiresIn = defaultInviteExpireMinute
+	}
+	if expiresIn < 0 || expiresIn > maxInviteExpireMinute {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "expires_in_minutes must be between 1 and 43200", common.ErrFromClient)
+	}
+
+	room, err := uc.Repository.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	if room.OwnerUserID != userID {
+		return nil, common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "only the room owner can create invites", common.ErrFromClient)
+	}
+
+	code, err := generateInviteCode()
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	invite := &mysql.RoomInvite{
+		Code:            code,
+		RoomID:          room.ID,
+		Role:            role,
+		CreatedByUserID: userID,
+		ExpiresAt:       time.Now().Add(time.Duration(expiresIn) * time.Minute),
+	}
+
+	if err := uc.Repository.CreateInvite(ctx, invite); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return &response.ResRoomInvite{
+		Code:      invite.Code,
+		RoomID:    invite.RoomID,
+		Role:      string(invite.Role),
+		ExpiresAt: invite.ExpiresAt,
+	}, nil
+}
+
+// addMember 방 멤버 추가 (동시 참여 요청으로 먼저 추가된 멤버가 있으면 그 멤버를 반환)
+func (uc *JoinRoomUseCase) addMember(ctx context.Context, roomID uint, userID uint, role mysql.RoomRole) (*mysql.RoomMember, error) {
+	member := &mysql.RoomMember{
+		RoomID: roomID,
+		UserID: userID,
+		Role:   role,
+	}
+
+	err := uc.Repository.AddMember(ctx, member)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		member, err = uc.Repository.GetMember(ctx, roomID, userID)
+		if err != nil {
+			return nil, roomMemberDBError(ctx, err)
+		}
+		return member, nil
+	}
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return member, nil
+}
+
+// resolveCode 초대 코드를 먼저 확인하고, 없으면 방 고유 코드(UUID)로 조회 (방 고유 코드는 소유자만 볼 수 있으므로 참여 시 editor 권한)
+func (uc *JoinRoomUseCase) resolveCode(ctx context.Context, code string) (*mysql.Room, mysql.RoomRole, error) {
+	invite, err := uc.Repository.GetInviteByCode(ctx, strings.ToUpper(code))
+	if err == nil {
+		if time.Now().After(invite.ExpiresAt) {
+			return nil, "", common.ErrorMsg(ctx, common.ErrCodeNotFound, common.Trace(), "invite code expired", common.ErrFromClient)
+		}
+		room, err := uc.Repository.GetByID(ctx, invite.RoomID)
+		if err != nil {
+			return nil, "", roomDBError(ctx, err)
+		}
+		return room, invite.Role, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, "", common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	room, err := uc.Repository.GetByRoomCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, "", common.ErrorMsg(ctx, common.ErrCodeNotFound, common.Trace(), "invalid room code", common.ErrFromClient)
+		}
+		return nil, "", common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return room, mysql.RoomRoleEditor, nil
+}
+
+// generateInviteCode 초대 코드 생성 (crypto/rand 사용)
+func generateInviteCode() (string, error) {
+	max := big.NewInt(int64(len(inviteCodeAlphabet)))
+	code := make([]byte, inviteCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = inviteCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/response"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RoomMemberUseCase struct {
+	Repository     _interface.IRoomMemberRepository
+	ContextTimeout time.Duration
+}
+
+func NewRoomMemberUseCase(repo _interface.IRoomMemberRepository, timeout time.Duration) _interface.IRoomMemberUseCase {
+	return &RoomMemberUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// GetMembers 방 멤버 목록 조회 (방 멤버만 가능)
+func (uc *RoomMemberUseCase) GetMembers(ctx context.Context, roomID uint, userID uint) (*response.ResRoomMemberList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if _, err := uc.Repository.GetMember(ctx, roomID, userID); err != nil {
+		return nil, roomMemberDBError(ctx, err)
+	}
+
+	members, err := uc.Repository.GetMembers(ctx, roomID)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	resMembers := make([]response.ResRoomMember, len(members))
+	for i, member := range members {
+		resMembers[i] = response.ResRoomMember{
+			UserID:   member.UserID,
+			Role:     string(member.Role),
+			JoinedAt: member.CreatedAt,
+		}
+		if member.User != nil {
+			resMembers[i].AccountID = member.User.AccountID
+			resMembers[i].Nickname = member.User.Nickname
+		}
+	}
+
+	return &response.ResRoomMemberList{
+		Members: resMembers,
+		Total:   int64(len(resMembers)),
+	}, nil
+}
+
+// KickMember 방 멤버 강퇴 (소유자만 가능, 소유자 본인은 강퇴 불가, 강퇴된 사용자는 소유자가 취소할 때까지 다시 참여 불가)
+func (uc *RoomMemberUseCase) KickMember(ctx context.Context, roomID uint, userID uint, targetUserID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	requester, err := uc.Repository.GetMember(ctx, roomID, userID)
+	if err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	if requester.Role != mysql.RoomRoleOwner {
+		return common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "only the room owner can remove members", common.ErrFromClient)
+	}
+
+	if targetUserID == userID {
+		return common.ErrorMsg(ctx, common.ErrBadRequest, common.Trace(), "room owner cannot remove themselves", common.ErrFromClient)
+	}
+
+	if err := uc.Repository.KickMember(ctx, roomID, targetUserID, userID); err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	return nil
+}
+
+// CancelKick 강퇴 취소 (소유자만 가능, 취소하면 초대 코드로 다시 참여 가능)
+func (uc *RoomMemberUseCase) CancelKick(ctx context.Context, roomID uint, userID uint, targetUserID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	requester, err := uc.Repository.GetMember(ctx, roomID, userID)
+	if err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	if requester.Role != mysql.RoomRoleOwner {
+		return common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "only the room owner can cancel kicks", common.ErrFromClient)
+	}
+
+	if err := uc.Repository.DeleteKick(ctx, roomID, targetUserID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "user was not kicked from the room", common.ErrFromClient)
+		}
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return nil
+}
+
+// LeaveRoom 방 나가기 (소유자는 나갈 수 없음)
+func (uc *RoomMemberUseCase) LeaveRoom(ctx context.Context, roomID uint, userID uint) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	member, err := uc.Repository.GetMember(ctx, roomID, userID)
+	if err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	if member.Role == mysql.RoomRoleOwner {
+		return common.ErrorMsg(ctx, common.ErrBadRequest, common.Trace(), "room owner cannot leave the room, delete the room instead", common.ErrFromClient)
+	}
+
+	if err := uc.Repository.RemoveMember(ctx, roomID, userID); err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	return nil
+}
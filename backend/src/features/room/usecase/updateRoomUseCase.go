@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"main/common"
+	"main/common/db/mysql"
+	_interface "main/features/room/model/interface"
+	"main/features/room/model/request"
+	"main/features/room/model/response"
+	"time"
+)
+
+type UpdateRoomUseCase struct {
+	Repository     _interface.IUpdateRoomRepository
+	ContextTimeout time.Duration
+}
+
+func NewUpdateRoomUseCase(repo _interface.IUpdateRoomRepository, timeout time.Duration) _interface.IUpdateRoomUseCase {
+	return &UpdateRoomUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// UpdateRoom 방 이름 변경 (소유자만 가능)
+func (uc *UpdateRoomUseCase) UpdateRoom(ctx context.Context, roomID uint, userID uint, req request.ReqUpdateRoom) (*response.ResRoom, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	name, err := request.ValidateRoomName(req.Name)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	room, err := uc.Repository.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	if room.OwnerUserID != userID {
+		return nil, common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "only the room owner can rename the room", common.ErrFromClient)
+	}
+
+	if err := uc.Repository.UpdateName(ctx, roomID, name); err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	// 변경된 방 조회
+	updatedRoom, err := uc.Repository.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	defaultRoomID, err := uc.Repository.GetDefaultRoomID(ctx, userID)
+	if err != nil {
+		return nil, roomDBError(ctx, err)
+	}
+
+	return convertRoomToResponse(updatedRoom, userID, mysql.RoomRoleOwner, defaultRoomID), nil
+}
+
+// SetDefaultRoom 사용자의 기본 방 변경 (멤버로 속한 방만 가능)
+func (uc *UpdateRoomUseCase) SetDefaultRoom(ctx context.Context, userID uint, req request.ReqSetDefaultRoom) error {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	if req.RoomID == 0 {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "room_id is required", common.ErrFromClient)
+	}
+
+	if _, err := uc.Repository.GetByID(ctx, req.RoomID); err != nil {
+		return roomDBError(ctx, err)
+	}
+
+	if _, err := uc.Repository.GetMember(ctx, req.RoomID, userID); err != nil {
+		return roomMemberDBError(ctx, err)
+	}
+
+	if err := uc.Repository.SetDefaultRoom(ctx, userID, req.RoomID); err != nil {
+		return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return nil
+}
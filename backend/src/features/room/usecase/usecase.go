@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"main/common"
+	"main/common/db/mysql"
+	"main/features/room/model/response"
+
+	"gorm.io/gorm"
+)
+
+// convertRoomToResponse mysql.Room을 response.ResRoom으로 변환
+// 방 고유 코드는 그대로 참여에 쓰이므로 소유자에게만 내려줌
+func convertRoomToResponse(room *mysql.Room, userID uint, role mysql.RoomRole, defaultRoomID *uint) *response.ResRoom {
+	roomCode := ""
+	if role == mysql.RoomRoleOwner {
+		roomCode = room.RoomCode
+	}
+
+	return &response.ResRoom{
+		ID:          room.ID,
+		RoomCode:    roomCode,
+		Name:        room.Name,
+		OwnerUserID: room.OwnerUserID,
+		IsOwner:     room.OwnerUserID == userID,
+		Role:        string(role),
+		IsDefault:   defaultRoomID != nil && *defaultRoomID == room.ID,
+		CreatedAt:   room.CreatedAt,
+		UpdatedAt:   room.UpdatedAt,
+	}
+}
+
+// roomDBError 방 조회/변경 중 발생한 DB 에러를 공통 에러 형식으로 변환
+func roomDBError(ctx context.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.ErrorMsg(ctx, common.ErrRoomNotFound, common.Trace(), "room not found", common.ErrFromClient)
+	}
+	return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+}
+
+// roomMemberDBError 방 멤버 조회 중 발생한 DB 에러를 공통 에러 형식으로 변환
+func roomMemberDBError(ctx context.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.ErrorMsg(ctx, common.ErrRoomUserNotFound, common.Trace(), "user does not belong to the room", common.ErrFromClient)
+	}
+	return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/tag/model/interface"
+	"main/features/tag/model/request"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type GetTagHandler struct {
+	UseCase _interface.IGetTagUseCase
+}
+
+func NewGetTagHandler(c *echo.Group, useCase _interface.IGetTagUseCase) _interface.IGetTagHandler {
+	handler := &GetTagHandler{
+		UseCase: useCase,
+	}
+	c.GET("/tags", handler.GetTagList)
+	return handler
+}
+
+// GetTagList 태그 자동완성 API
+// @Router /v0.1/tags [get]
+// @Summary 태그 자동완성 API
+// @Description 사용자가 속한 방의 태그를 접두어로 검색합니다 (사용 횟수가 많은 순)
+// @Produce json
+// @Param prefix query string false "태그 이름 접두어"
+// @Param room_id query int false "Room ID filter"
+// @Param limit query int false "최대 개수 (기본 10, 최대 50)"
+// @Success 200 {object} response.ResTagList
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags tag
+func (h *GetTagHandler) GetTagList(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	req := request.ReqGetTagList{
+		Prefix: c.QueryParam("prefix"),
+	}
+
+	if roomIDStr := c.QueryParam("room_id"); roomIDStr != "" {
+		parsedRoomID, err := strconv.ParseUint(roomIDStr, 10, 32)
+		if err != nil {
+			return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid room_id format", common.ErrFromClient)
+		}
+		roomID := uint(parsedRoomID)
+		req.RoomID = &roomID
+	}
+
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid limit format", common.ErrFromClient)
+		}
+		req.Limit = limit
+	}
+
+	tagList, err := h.UseCase.GetTagList(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tagList)
+}
@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"main/common/db/mysql"
+	"main/features/tag/repository"
+	"main/features/tag/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func NewTagHandlers(e *echo.Group) {
+	timeout := 30 * time.Second
+
+	// Get
+	getRepo := repository.NewGetTagRepository(mysql.GormMysqlDB)
+	getUseCase := usecase.NewGetTagUseCase(getRepo, timeout)
+	NewGetTagHandler(e, getUseCase)
+}
@@ -0,0 +1,7 @@
+package _interface
+
+import "github.com/labstack/echo/v4"
+
+type IGetTagHandler interface {
+	GetTagList(c echo.Context) error
+}
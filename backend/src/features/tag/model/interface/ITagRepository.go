@@ -0,0 +1,13 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+	"main/features/tag/model/request"
+	"main/features/tag/model/response"
+)
+
+type IGetTagRepository interface {
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	GetListByPrefix(ctx context.Context, userID uint, req request.ReqGetTagList) ([]response.ResTag, error)
+}
@@ -0,0 +1,11 @@
+package _interface
+
+import (
+	"context"
+	"main/features/tag/model/request"
+	"main/features/tag/model/response"
+)
+
+type IGetTagUseCase interface {
+	GetTagList(ctx context.Context, userID uint, req request.ReqGetTagList) (*response.ResTagList, error)
+}
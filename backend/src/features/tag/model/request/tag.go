@@ -0,0 +1,12 @@
+package request
+
+const (
+	DefaultTagListLimit = 10
+	MaxTagListLimit     = 50
+)
+
+type ReqGetTagList struct {
+	Prefix string // 태그 이름 접두어 (없으면 많이 쓰인 순)
+	RoomID *uint  // 없으면 사용자가 속한 모든 방
+	Limit  int    // 기본 10, 최대 50
+}
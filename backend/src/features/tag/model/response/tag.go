@@ -0,0 +1,10 @@
+package response
+
+type ResTag struct {
+	Name       string `json:"name"`
+	UsageCount int64  `json:"usage_count"` // 태그가 달린 메모 수
+}
+
+type ResTagList struct {
+	Tags []ResTag `json:"tags"`
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	_interface "main/features/tag/model/interface"
+	"main/features/tag/model/request"
+	"main/features/tag/model/response"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type GetTagRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewGetTagRepository(gormDB *gorm.DB) _interface.IGetTagRepository {
+	return &GetTagRepository{
+		GormDB: gormDB,
+	}
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *GetTagRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetListByPrefix 사용자가 속한 방의 태그를 접두어로 조회 (삭제되지 않은 메모 기준 사용 횟수 순)
+func (r *GetTagRepository) GetListByPrefix(ctx context.Context, userID uint, req request.ReqGetTagList) ([]response.ResTag, error) {
+	var tags []response.ResTag
+	query := r.GormDB.WithContext(ctx).
+		Table("tags").
+		Select("tags.name AS name, COUNT(memos.id) AS usage_count").
+		Joins("LEFT JOIN memo_tags ON memo_tags.tag_id = tags.id").
+		Joins("LEFT JOIN memos ON memos.id = memo_tags.memo_id AND memos.deleted_at IS NULL").
+		Where("tags.room_id IN (SELECT room_id FROM room_members WHERE user_id = ?)", userID)
+
+	if req.RoomID != nil {
+		query = query.Where("tags.room_id = ?", *req.RoomID)
+	}
+
+	if req.Prefix != "" {
+		query = query.Where("tags.name LIKE ?", escapeLike(req.Prefix)+"%")
+	}
+
+	result := query.
+		Group("tags.name").
+		Order("usage_count DESC").
+		Order("tags.name ASC").
+		Limit(req.Limit).
+		Scan(&tags)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return tags, nil
+}
+
+// escapeLike LIKE 패턴의 특수 문자 이스케이프
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
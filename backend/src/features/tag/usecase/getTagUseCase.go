@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"main/common"
+	_interface "main/features/tag/model/interface"
+	"main/features/tag/model/request"
+	"main/features/tag/model/response"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type GetTagUseCase struct {
+	Repository     _interface.IGetTagRepository
+	ContextTimeout time.Duration
+}
+
+func NewGetTagUseCase(repo _interface.IGetTagRepository, timeout time.Duration) _interface.IGetTagUseCase {
+	return &GetTagUseCase{
+		Repository:     repo,
+		ContextTimeout: timeout,
+	}
+}
+
+// GetTagList 태그 자동완성 목록 조회 (사용 횟수 포함)
+func (uc *GetTagUseCase) GetTagList(ctx context.Context, userID uint, req request.ReqGetTagList) (*response.ResTagList, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	req.Prefix = strings.Join(strings.Fields(req.Prefix), " ")
+
+	if req.Limit == 0 {
+		req.Limit = request.DefaultTagListLimit
+	}
+	if req.Limit < 1 || req.Limit > request.MaxTagListLimit {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "limit must be between 1 and 50", common.ErrFromClient)
+	}
+
+	// 특정 방을 지정한 경우 멤버인지 확인
+	if req.RoomID != nil {
+		if _, err := uc.Repository.GetRoomMember(ctx, *req.RoomID, userID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, common.ErrorMsg(ctx, common.ErrRoomUserNotFound, common.Trace(), "user does not belong to the room", common.ErrFromClient)
+			}
+			return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+	}
+
+	tags, err := uc.Repository.GetListByPrefix(ctx, userID, req)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	if tags == nil {
+		tags = []response.ResTag{}
+	}
+
+	return &response.ResTagList{
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"main/common/db/mysql"
+	"main/common/storage"
+	"main/features/upload/repository"
+	"main/features/upload/usecase"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func NewUploadHandlers(e *echo.Group) {
+	timeout := 30 * time.Second
+
+	// Upload
+	uploadRepo := repository.NewUploadRepository(mysql.GormMysqlDB)
+	uploadUseCase := usecase.NewUploadUseCase(uploadRepo, storage.Default, timeout)
+	NewUploadHandler(e, uploadUseCase)
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"main/common"
+	_interface "main/features/upload/model/interface"
+	"main/features/upload/model/request"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type UploadHandler struct {
+	UseCase _interface.IUploadUseCase
+}
+
+func NewUploadHandler(c *echo.Group, useCase _interface.IUploadUseCase) _interface.IUploadHandler {
+	handler := &UploadHandler{
+		UseCase: useCase,
+	}
+	c.POST("/uploads", handler.CreateUpload)
+	c.POST("/uploads/:id/complete", handler.CompleteUpload)
+	return handler
+}
+
+// CreateUpload presigned 업로드 URL 발급 API
+// @Router /v0.1/uploads [post]
+// @Summary presigned 업로드 URL 발급 API
+// @Description 저장소에 이미지를 직접 업로드할 presigned PUT URL과 업로드 ID를 발급합니다 (purpose: memo/profile, JPEG/PNG/WebP)
+// @Description upload_url로 headers를 포함해 PUT 요청한 뒤 expires_at 전에 완료 처리 API를 호출해야 합니다
+// @Accept json
+// @Produce json
+// @Param request body request.ReqCreateUpload true "업로드 요청 데이터"
+// @Success 201 {object} response.ResUpload
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags upload
+func (h *UploadHandler) CreateUpload(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	var req request.ReqCreateUpload
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request body", common.ErrFromClient)
+	}
+
+	upload, err := h.UseCase.CreateUpload(ctx, userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, upload)
+}
+
+// CompleteUpload 업로드 완료 처리 API
+// @Router /v0.1/uploads/{id}/complete [post]
+// @Summary 업로드 완료 처리 API
+// @Description 저장소에 올라간 파일의 크기와 Content-Type을 확인한 뒤 메모 이미지(memo_id 필수, 마지막 순서에 추가) 또는 프로필 이미지로 연결합니다
+// @Description 업로드한 파일은 서버에서 다시 인코딩해 원본/중간/썸네일 크기로 저장하므로 GPS 등 EXIF 메타데이터가 공개되지 않으며, 업로드한 원본 파일은 삭제됩니다
+// @Accept json
+// @Produce json
+// @Param id path string true "업로드 ID"
+// @Param request body request.ReqCompleteUpload false "완료 처리 요청 데이터"
+// @Success 200 {object} response.ResCompletedUpload
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Tags upload
+func (h *UploadHandler) CompleteUpload(c echo.Context) error {
+	ctx, userID, _ := common.CtxGenerate(c)
+
+	var req request.ReqCompleteUpload
+	if err := c.Bind(&req); err != nil {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "invalid request body", common.ErrFromClient)
+	}
+
+	upload, err := h.UseCase.CompleteUpload(ctx, c.Param("id"), userID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, upload)
+}
@@ -0,0 +1,8 @@
+package _interface
+
+import "github.com/labstack/echo/v4"
+
+type IUploadHandler interface {
+	CreateUpload(c echo.Context) error
+	CompleteUpload(c echo.Context) error
+}
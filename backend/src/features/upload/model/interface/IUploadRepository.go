@@ -0,0 +1,17 @@
+package _interface
+
+import (
+	"context"
+	"main/common/db/mysql"
+)
+
+type IUploadRepository interface {
+	Create(ctx context.Context, upload *mysql.Upload) error
+	GetByUploadID(ctx context.Context, uploadID string, userID uint) (*mysql.Upload, error)
+	Delete(ctx context.Context, id uint) error
+	GetMemo(ctx context.Context, memoID uint) (*mysql.Memo, error)
+	GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error)
+	GetUser(ctx context.Context, userID uint) (*mysql.User, error)
+	AttachMemoImage(ctx context.Context, upload *mysql.Upload, image *mysql.MemoImage) error
+	AttachProfileImage(ctx context.Context, upload *mysql.Upload, profileImageKey string, profileImageURL string) error
+}
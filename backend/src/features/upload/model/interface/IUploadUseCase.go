@@ -0,0 +1,12 @@
+package _interface
+
+import (
+	"context"
+	"main/features/upload/model/request"
+	"main/features/upload/model/response"
+)
+
+type IUploadUseCase interface {
+	CreateUpload(ctx context.Context, userID uint, req request.ReqCreateUpload) (*response.ResUpload, error)
+	CompleteUpload(ctx context.Context, uploadID string, userID uint, req request.ReqCompleteUpload) (*response.ResCompletedUpload, error)
+}
@@ -0,0 +1,14 @@
+package request
+
+// ReqCreateUpload presigned 업로드 URL 발급 요청
+type ReqCreateUpload struct {
+	Purpose     string `json:"purpose"`      // memo 또는 profile
+	ContentType string `json:"content_type"` // image/jpeg, image/png, image/webp
+	Size        int64  `json:"size"`         // 업로드할 파일 크기 (bytes)
+}
+
+// ReqCompleteUpload 업로드 완료 처리 요청 (memo 용도면 memo_id 필수)
+type ReqCompleteUpload struct {
+	MemoID  *uint   `json:"memo_id"`
+	Caption *string `json:"caption"`
+}
@@ -0,0 +1,21 @@
+package response
+
+import "time"
+
+// ResUpload presigned 업로드 URL 발급 응답 (upload_url로 PUT 요청 시 headers를 그대로 보내야 함)
+type ResUpload struct {
+	UploadID  string            `json:"upload_id"`
+	UploadURL string            `json:"upload_url"`
+	Method    string            `json:"method"`
+	Headers   map[string]string `json:"headers"`
+	ExpiresAt time.Time         `json:"expires_at"` // 업로드 후 이 시간 전에 완료 처리해야 함
+}
+
+// ResCompletedUpload 업로드 완료 처리 응답
+type ResCompletedUpload struct {
+	UploadID string `json:"upload_id"`
+	Purpose  string `json:"purpose"`
+	URL      string `json:"url"`
+	MemoID   *uint  `json:"memo_id,omitempty"`
+	ImageID  *uint  `json:"image_id,omitempty"`
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"main/common/db/mysql"
+	memoRepository "main/features/memo/repository"
+	_interface "main/features/upload/model/interface"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type UploadRepository struct {
+	GormDB *gorm.DB
+}
+
+func NewUploadRepository(gormDB *gorm.DB) _interface.IUploadRepository {
+	return &UploadRepository{
+		GormDB: gormDB,
+	}
+}
+
+// Create 업로드 생성
+func (r *UploadRepository) Create(ctx context.Context, upload *mysql.Upload) error {
+	return r.GormDB.WithContext(ctx).Create(upload).Error
+}
+
+// GetByUploadID 본인의 업로드 조회
+func (r *UploadRepository) GetByUploadID(ctx context.Context, uploadID string, userID uint) (*mysql.Upload, error) {
+	var upload mysql.Upload
+	result := r.GormDB.WithContext(ctx).
+		Where("upload_id = ? AND user_id = ?", uploadID, userID).
+		First(&upload)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &upload, nil
+}
+
+// Delete 업로드 삭제 (검증에 실패한 업로드)
+func (r *UploadRepository) Delete(ctx context.Context, id uint) error {
+	return r.GormDB.WithContext(ctx).Where("id = ?", id).Delete(&mysql.Upload{}).Error
+}
+
+// GetMemo 이미지를 연결할 메모 조회 (이미지 수 확인용으로 이미지 포함)
+func (r *UploadRepository) GetMemo(ctx context.Context, memoID uint) (*mysql.Memo, error) {
+	var memo mysql.Memo
+	result := r.GormDB.WithContext(ctx).
+		Preload("Images").
+		Where("id = ?", memoID).
+		First(&memo)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &memo, nil
+}
+
+// GetRoomMember 방 멤버 조회 (권한 확인용)
+func (r *UploadRepository) GetRoomMember(ctx context.Context, roomID uint, userID uint) (*mysql.RoomMember, error) {
+	var member mysql.RoomMember
+	result := r.GormDB.WithContext(ctx).
+		Where("room_id = ? AND user_id = ?", roomID, userID).
+		First(&member)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &member, nil
+}
+
+// GetUser 사용자 조회 (이전 프로필 이미지 확인용)
+func (r *UploadRepository) GetUser(ctx context.Context, userID uint) (*mysql.User, error) {
+	var user mysql.User
+	result := r.GormDB.WithContext(ctx).
+		Where("id = ?", userID).
+		First(&user)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &user, nil
+}
+
+// AttachMemoImage 업로드를 완료 처리하고 메모 이미지 뒤에 추가 (이미지가 없던 메모면 대표 이미지가 됨)
+func (r *UploadRepository) AttachMemoImage(ctx context.Context, upload *mysql.Upload, image *mysql.MemoImage) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := markUploadCompleted(tx, upload); err != nil {
+			return err
+		}
+
+		images := []mysql.MemoImage{*image}
+		if err := memoRepository.AppendMemoImages(tx, image.MemoID, images); err != nil {
+			return err
+		}
+		*image = images[0]
+
+		return memoRepository.SyncMemoCover(tx, image.MemoID)
+	})
+}
+
+// AttachProfileImage 업로드를 완료 처리하고 다시 인코딩한 이미지를 프로필 이미지로 설정 (다음 교체 때 삭제할 원본 키도 함께 저장)
+func (r *UploadRepository) AttachProfileImage(ctx context.Context, upload *mysql.Upload, profileImageKey string, profileImageURL string) error {
+	return r.GormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := markUploadCompleted(tx, upload); err != nil {
+			return err
+		}
+
+		return tx.Model(&mysql.User{}).
+			Where("id = ?", upload.UserID).
+			Updates(map[string]interface{}{
+				"profile_image_url":          profileImageURL,
+				"profile_image_key":          profileImageKey,
+				"profile_image_has_variants": true,
+			}).Error
+	})
+}
+
+// markUploadCompleted 대기 중인 업로드를 완료 상태로 변경 (이미 완료된 업로드면 gorm.ErrRecordNotFound)
+func markUploadCompleted(tx *gorm.DB, upload *mysql.Upload) error {
+	now := time.Now()
+	result := tx.Model(&mysql.Upload{}).
+		Where("id = ? AND status = ?", upload.ID, mysql.UploadStatusPending).
+		Updates(map[string]interface{}{
+			"status":       mysql.UploadStatusCompleted,
+			"completed_at": now,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	upload.Status = mysql.UploadStatusCompleted
+	upload.CompletedAt = &now
+	return nil
+}
@@ -0,0 +1,327 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"main/common"
+	"main/common/db/mysql"
+	"main/common/storage"
+	memoRequest "main/features/memo/model/request"
+	_interface "main/features/upload/model/interface"
+	"main/features/upload/model/request"
+	"main/features/upload/model/response"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	presignedUploadExpires = 15 * time.Minute // presigned PUT URL 유효 시간
+	PendingUploadTTL       = time.Hour        // 발급 후 완료 처리까지 허용하는 시간 (이후 미완료 업로드는 정리 대상, 이미지 정리 작업의 최소 유예 시간)
+)
+
+// uploadFolders 업로드 용도별 저장 폴더
+var uploadFolders = map[mysql.UploadPurpose]string{
+	mysql.UploadPurposeMemo:    storage.MemoImageFolder,
+	mysql.UploadPurposeProfile: storage.ProfileImageFolder,
+}
+
+type UploadUseCase struct {
+	Repository     _interface.IUploadRepository
+	Storage        storage.ObjectStorage
+	ContextTimeout time.Duration
+}
+
+func NewUploadUseCase(repo _interface.IUploadRepository, store storage.ObjectStorage, timeout time.Duration) _interface.IUploadUseCase {
+	return &UploadUseCase{
+		Repository:     repo,
+		Storage:        store,
+		ContextTimeout: timeout,
+	}
+}
+
+// CreateUpload presigned PUT URL 발급 (이미지 데이터는 API 서버를 거치지 않고 저장소로 바로 업로드)
+func (uc *UploadUseCase) CreateUpload(ctx context.Context, userID uint, req request.ReqCreateUpload) (*response.ResUpload, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	purpose := mysql.UploadPurpose(req.Purpose)
+	if !purpose.IsValid() {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "purpose must be memo or profile", common.ErrFromClient)
+	}
+
+	contentType := strings.ToLower(strings.TrimSpace(req.ContentType))
+	ext, ok := storage.UploadableImageExt[contentType]
+	if !ok {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "content_type must be image/jpeg, image/png or image/webp", common.ErrFromClient)
+	}
+
+	if err := storage.CheckFileSize(req.Size); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), err.Error(), common.ErrFromClient)
+	}
+
+	if uc.Storage == nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), storage.ErrNotConfigured.Error(), common.ErrFromInternal)
+	}
+
+	key := storage.NewImageKey(uploadFolders[purpose], ext)
+	uploadURL, err := uc.Storage.PresignPut(ctx, key, contentType, presignedUploadExpires)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	upload := &mysql.Upload{
+		UploadID:    uuid.New().String(),
+		UserID:      userID,
+		Purpose:     purpose,
+		ObjectKey:   key,
+		ContentType: contentType,
+		Size:        req.Size,
+		Status:      mysql.UploadStatusPending,
+		ExpiresAt:   time.Now().Add(PendingUploadTTL),
+	}
+	if err := uc.Repository.Create(ctx, upload); err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	return &response.ResUpload{
+		UploadID:  upload.UploadID,
+		UploadURL: uploadURL,
+		Method:    http.MethodPut,
+		Headers:   map[string]string{"Content-Type": contentType},
+		ExpiresAt: upload.ExpiresAt,
+	}, nil
+}
+
+// CompleteUpload 업로드된 객체를 HeadObject로 검증한 뒤 메모 이미지 또는 프로필 이미지로 연결
+// 직접 업로드한 원본은 GPS 등 EXIF가 남아 있으므로 서버 업로드와 같이 다시 인코딩한 크기별 이미지를 연결하고 원본은 삭제
+func (uc *UploadUseCase) CompleteUpload(ctx context.Context, uploadID string, userID uint, req request.ReqCompleteUpload) (*response.ResCompletedUpload, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.ContextTimeout)
+	defer cancel()
+
+	upload, err := uc.Repository.GetByUploadID(ctx, uploadID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "upload not found", common.ErrFromClient)
+		}
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	if upload.Status == mysql.UploadStatusCompleted {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "upload already completed", common.ErrFromClient)
+	}
+	if time.Now().After(upload.ExpiresAt) {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "upload expired", common.ErrFromClient)
+	}
+
+	// 연결 대상 권한을 먼저 확인한 뒤 저장소 조회
+	var memo *mysql.Memo
+	var user *mysql.User
+	switch upload.Purpose {
+	case mysql.UploadPurposeMemo:
+		memo, err = uc.checkMemoTarget(ctx, userID, req)
+		if err != nil {
+			return nil, err
+		}
+	case mysql.UploadPurposeProfile:
+		user, err = uc.Repository.GetUser(ctx, userID)
+		if err != nil {
+			return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+		}
+	}
+
+	if err := uc.verifyUploadedObject(ctx, upload); err != nil {
+		return nil, err
+	}
+
+	uploaded, err := uc.reencodeUploadedObject(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &response.ResCompletedUpload{
+		UploadID: upload.UploadID,
+		Purpose:  string(upload.Purpose),
+		URL:      uploaded.URLs[storage.ImageVariantOriginal],
+	}
+
+	switch upload.Purpose {
+	case mysql.UploadPurposeMemo:
+		image := &mysql.MemoImage{
+			MemoID:      memo.ID,
+			ObjectKey:   uploaded.Key,
+			HasVariants: true,
+			Caption:     req.Caption,
+			Width:       uploaded.Width,
+			Height:      uploaded.Height,
+		}
+		if err := uc.Repository.AttachMemoImage(ctx, upload, image); err != nil {
+			uc.discardImage(ctx, uploaded.Key)
+			return nil, attachDBError(ctx, err)
+		}
+		res.MemoID, res.ImageID = &memo.ID, &image.ID
+
+	case mysql.UploadPurposeProfile:
+		if err := uc.Repository.AttachProfileImage(ctx, upload, uploaded.Key, res.URL); err != nil {
+			uc.discardImage(ctx, uploaded.Key)
+			return nil, attachDBError(ctx, err)
+		}
+
+		// 이전에 서버가 저장한 프로필 이미지 삭제 (profile_image_key만 삭제, 외부 URL이나 기존 데이터는 이미지 정리 작업에 맡김, 실패해도 무시)
+		if user.ProfileImageKey != "" {
+			if err := storage.DeleteImageObjects(ctx, uc.Storage, user.ProfileImageKey, user.ProfileImageHasVariants); err != nil {
+				fmt.Printf("⚠️ 이전 프로필 이미지 삭제 실패: %v\n", err)
+			}
+		}
+	}
+
+	// EXIF가 남아 있는 원본 삭제 (실패해도 어디에도 연결되지 않은 객체이므로 이미지 정리 작업에서 삭제됨)
+	if err := uc.Storage.Delete(ctx, upload.ObjectKey); err != nil {
+		fmt.Printf("⚠️ 직접 업로드한 원본 삭제 실패: %v\n", err)
+	}
+
+	return res, nil
+}
+
+// checkMemoTarget 이미지를 추가할 메모 조회 및 권한/이미지 수/설명 길이 확인
+func (uc *UploadUseCase) checkMemoTarget(ctx context.Context, userID uint, req request.ReqCompleteUpload) (*mysql.Memo, error) {
+	if req.MemoID == nil {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "memo_id is required for memo uploads", common.ErrFromClient)
+	}
+	if req.Caption != nil && utf8.RuneCountInString(*req.Caption) > memoRequest.MaxMemoImageCaptionLen {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("caption must be at most %d characters", memoRequest.MaxMemoImageCaptionLen), common.ErrFromClient)
+	}
+
+	memo, err := uc.Repository.GetMemo(ctx, *req.MemoID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrorMsg(ctx, common.ErrNotFound, common.Trace(), "memo not found", common.ErrFromClient)
+		}
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+
+	member, err := uc.Repository.GetRoomMember(ctx, memo.RoomID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrorMsg(ctx, common.ErrRoomUserNotFound, common.Trace(), "user does not belong to the room", common.ErrFromClient)
+		}
+		return nil, common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+	}
+	if !member.Role.CanEdit() {
+		return nil, common.ErrorMsg(ctx, common.ErrNotOwner, common.Trace(), "viewer cannot create or edit memos", common.ErrFromClient)
+	}
+
+	if len(memo.Images) >= memoRequest.MaxMemoImages {
+		return nil, common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), fmt.Sprintf("a memo can have at most %d images", memoRequest.MaxMemoImages), common.ErrFromClient)
+	}
+
+	return memo, nil
+}
+
+// verifyUploadedObject 업로드된 객체 검증 (HeadObject로 크기/Content-Type, 앞부분 magic bytes로 실제 형식과 픽셀 크기 확인)
+// 요청과 다르거나 허용하지 않는 파일이면 객체와 업로드를 삭제하고 ErrBadParameter 반환
+func (uc *UploadUseCase) verifyUploadedObject(ctx context.Context, upload *mysql.Upload) error {
+	if uc.Storage == nil {
+		return common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), storage.ErrNotConfigured.Error(), common.ErrFromInternal)
+	}
+
+	info, err := uc.Storage.Head(ctx, upload.ObjectKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "file has not been uploaded to upload_url yet", common.ErrFromClient)
+		}
+		return common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	reason, err := uc.checkUploadedObject(ctx, upload, info)
+	if err != nil {
+		return common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+	if reason != "" {
+		return uc.rejectUpload(ctx, upload, reason)
+	}
+	return nil
+}
+
+// checkUploadedObject 업로드된 객체가 요청과 같은지 확인 (거부 사유가 있으면 reason으로 반환)
+func (uc *UploadUseCase) checkUploadedObject(ctx context.Context, upload *mysql.Upload, info *storage.ObjectInfo) (string, error) {
+	if err := storage.CheckFileSize(info.Size); err != nil {
+		return err.Error(), nil
+	}
+	if info.Size > upload.Size {
+		return fmt.Sprintf("uploaded file is larger than the requested size (%d > %d bytes)", info.Size, upload.Size), nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(info.ContentType)
+	if !strings.EqualFold(mediaType, upload.ContentType) {
+		return fmt.Sprintf("uploaded content type %q does not match the requested %q", info.ContentType, upload.ContentType), nil
+	}
+
+	// Content-Type은 클라이언트가 보낸 값이므로 실제 파일 앞부분으로 형식 확인
+	header, err := uc.Storage.GetPrefix(ctx, upload.ObjectKey, storage.ImageSniffLen)
+	if err != nil {
+		return "", err
+	}
+	imageInfo, err := storage.ValidateImageHeader(header)
+	if err != nil {
+		return err.Error(), nil
+	}
+	if imageInfo.ContentType != upload.ContentType {
+		return fmt.Sprintf("uploaded file is %s, not %s", imageInfo.ContentType, upload.ContentType), nil
+	}
+
+	return "", nil
+}
+
+// reencodeUploadedObject 직접 업로드된 원본을 읽어 서버 업로드와 같이 다시 인코딩하고 새 키에 크기별로 저장 (GPS 등 EXIF 제거)
+// 디코딩할 수 없는 파일이면 객체와 업로드를 삭제하고 ErrBadParameter 반환
+func (uc *UploadUseCase) reencodeUploadedObject(ctx context.Context, upload *mysql.Upload) (*storage.UploadedImage, error) {
+	data, _, err := uc.Storage.Get(ctx, upload.ObjectKey)
+	if err != nil {
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	uploaded, err := storage.UploadImage(ctx, uc.Storage, bytes.NewReader(data), uploadFolders[upload.Purpose])
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidImage) {
+			return nil, uc.rejectUpload(ctx, upload, err.Error())
+		}
+		return nil, common.ErrorMsg(ctx, common.ErrInternalServer, common.Trace(), err.Error(), common.ErrFromInternal)
+	}
+
+	return uploaded, nil
+}
+
+// rejectUpload 잘못된 파일은 다시 사용할 수 없도록 객체와 업로드를 정리하고 ErrBadParameter 반환 (정리에 실패해도 미완료 업로드로 남아 정리 대상이 됨)
+func (uc *UploadUseCase) rejectUpload(ctx context.Context, upload *mysql.Upload, reason string) error {
+	if err := uc.Storage.Delete(ctx, upload.ObjectKey); err != nil {
+		fmt.Printf("⚠️ 검증 실패한 업로드 파일 삭제 실패: %v\n", err)
+	}
+	if err := uc.Repository.Delete(ctx, upload.ID); err != nil {
+		fmt.Printf("⚠️ 검증 실패한 업로드 삭제 실패: %v\n", err)
+	}
+
+	return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), reason, common.ErrFromClient)
+}
+
+// discardImage 연결하지 못한 크기별 이미지 삭제 (실패해도 이미지 정리 작업에서 삭제됨)
+func (uc *UploadUseCase) discardImage(ctx context.Context, key string) {
+	if err := storage.DeleteImage(ctx, uc.Storage, key); err != nil {
+		fmt.Printf("⚠️ 연결하지 못한 업로드 이미지 삭제 실패: %v\n", err)
+	}
+}
+
+// attachDBError 업로드 연결 중 발생한 DB 에러 변환 (동시에 완료 처리된 업로드면 gorm.ErrRecordNotFound)
+func attachDBError(ctx context.Context, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "upload already completed", common.ErrFromClient)
+	}
+	return common.ErrorMsg(ctx, common.ErrInternalDB, common.Trace(), err.Error(), common.ErrFromMysqlDB)
+}
@@ -15,12 +15,13 @@ func InitMiddleware(e *echo.Echo) error {
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:3000", "http://localhost:5050", "http://192.168.0.102:5050"},
 		AllowMethods:     []string{echo.GET, echo.HEAD, echo.PUT, echo.PATCH, echo.POST, echo.DELETE},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "If-Match", "If-None-Match"},
+		ExposeHeaders:    []string{"ETag"}, // 메모 버전 (동시 수정 확인용 If-Match)
 		AllowCredentials: true,
 	}))
 
-	// multipart 메시지 크기 제한 설정 (기본값: 32MB -> 2GB)
-	e.Use(middleware.BodyLimit("2GB"))
+	// 요청 크기 제한 (multipart 메모 이미지 최대 11장 기준, 큰 이미지는 presigned 업로드(/v0.1/uploads)로 저장소에 직접 업로드)
+	e.Use(middleware.BodyLimit("128M"))
 
 	//Logger : 로깅 미들웨어
 	e.Use(Logger)
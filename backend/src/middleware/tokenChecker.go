@@ -1,19 +1,21 @@
 package _middleware
 
 import (
+	"strings"
+
 	"main/common"
 
 	"github.com/labstack/echo/v4"
 )
 
-// CheckJWT : check user's jwt token from "token" header value
+// CheckJWT : check user's jwt token from "Authorization: Bearer" header (legacy "tkn" header also accepted)
 func TokenChecker(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
 		// get jwt Token
-		accessToken := c.Request().Header.Get("tkn")
+		accessToken := extractAccessToken(c)
 		if accessToken == "" {
-			return common.ErrorMsg(ctx, common.ErrBadParameter, common.Trace(), "no access token in header", common.ErrFromClient)
+			return common.ErrorMsg(ctx, common.ErrInvalidAccessToken, common.Trace(), "no access token in header", common.ErrFromClient)
 		}
 
 		// verify & get Data
@@ -25,6 +27,9 @@ func TokenChecker(next echo.HandlerFunc) echo.HandlerFunc {
 		if err != nil {
 			return err
 		}
+		if uID == 0 {
+			return common.ErrorMsg(ctx, common.ErrInvalidAccessToken, common.Trace(), "access token has no user id", common.ErrFromClient)
+		}
 
 		// set token data to Context
 		c.Set("uID", uID)
@@ -34,3 +39,16 @@ func TokenChecker(next echo.HandlerFunc) echo.HandlerFunc {
 
 	}
 }
+
+// extractAccessToken Authorization 헤더의 Bearer 토큰을 우선 사용하고, 없으면 tkn 헤더를 사용
+func extractAccessToken(c echo.Context) string {
+	authHeader := c.Request().Header.Get(echo.HeaderAuthorization)
+	if authHeader != "" {
+		const prefix = "Bearer "
+		if len(authHeader) > len(prefix) && strings.EqualFold(authHeader[:len(prefix)], prefix) {
+			return strings.TrimSpace(authHeader[len(prefix):])
+		}
+		return ""
+	}
+	return c.Request().Header.Get("tkn")
+}